@@ -0,0 +1,328 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BindingFunc is invoked with the JSON encoded arguments the page passed to a binding.
+// The returned value is JSON encoded and delivered back into the page if the binding
+// was registered with ReturnResult.
+type BindingFunc func(source Source, args []json.RawMessage) (interface{}, error)
+
+type BindingOptions struct {
+	// Browsing contexts the binding is restricted to. If empty, the binding is exposed to all contexts.
+	Contexts []string
+
+	// Sandbox in which the binding is exposed. If empty, the binding is exposed to the page realm.
+	Sandbox string
+
+	// ReturnResult delivers the value returned by the BindingFunc back into the page. The page
+	// function then returns a promise that resolves with the result or rejects with the error.
+	ReturnResult bool
+}
+
+// Binding is a Go function exposed to the page as a global function.
+type Binding struct {
+	Name    string
+	channel string
+	fn      BindingFunc
+	opts    BindingOptions
+	script  *PreloadScript
+	session *Session
+	mu      sync.Mutex
+	errs    []error
+}
+
+type bindingCall struct {
+	ID   int               `json:"id"`
+	Args []json.RawMessage `json:"args"`
+}
+
+type bindingResult struct {
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+const bindingPreloadScript = `(name, returnResult) => (channel) => {
+	const pending = new Map();
+	let seq = 0;
+
+	const bindings = globalThis.__gowebdriverBindings = globalThis.__gowebdriverBindings || {};
+	bindings[name] = {
+		deliver(id, payload) {
+			const call = pending.get(id);
+			if (!call) {
+				return;
+			}
+			pending.delete(id);
+
+			const res = JSON.parse(payload);
+			if (res.error) {
+				call.reject(new Error(res.error));
+			} else {
+				call.resolve(res.result);
+			}
+		},
+	};
+
+	Object.defineProperty(globalThis, name, {
+		configurable: true,
+		value: (...args) => {
+			const id = ++seq;
+			channel(JSON.stringify({ id, args }));
+
+			if (!returnResult) {
+				return undefined;
+			}
+
+			return new Promise((resolve, reject) => pending.set(id, { resolve, reject }));
+		},
+	});
+}`
+
+const bindingDeliverFunction = `(name, id, payload) => globalThis.__gowebdriverBindings[name].deliver(id, payload)`
+
+// AddBinding exposes fn to the page as a global function with the given name. Each call in
+// the page is routed back to fn via a script channel.
+func (s *Session) AddBinding(name string, fn BindingFunc, optFns ...func(o *BindingOptions)) (*Binding, error) {
+	opts := BindingOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	nameJSON, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+
+	binding := &Binding{
+		Name:    name,
+		channel: fmt.Sprintf("gowebdriver-binding-%s", name),
+		fn:      fn,
+		opts:    opts,
+		session: s,
+	}
+
+	if err := s.addBinding(binding); err != nil {
+		return nil, err
+	}
+
+	functionDeclaration := fmt.Sprintf("(%s)(%s, %t)", bindingPreloadScript, nameJSON, opts.ReturnResult)
+
+	script, err := s.AddPreloadScript(functionDeclaration, func(o *AddPreloadScriptOptions) {
+		o.Arguments = []ChannelValue{NewChannelValue(binding.channel)}
+		o.Contexts = opts.Contexts
+		o.Sandbox = opts.Sandbox
+	})
+	if err != nil {
+		_ = s.removeBinding(binding)
+		return nil, err
+	}
+
+	binding.script = script
+
+	return binding, nil
+}
+
+// Remove removes the binding from new realms. Realms that already expose the binding keep the
+// global function, but calls to it are ignored. Removing the last binding unsubscribes from
+// script.message.
+func (b *Binding) Remove() error {
+	if b.script != nil {
+		if err := b.script.Remove(); err != nil {
+			return err
+		}
+	}
+
+	return b.session.removeBinding(b)
+}
+
+// addBinding registers the binding. All bindings share one listener and subscription for
+// script.message, which are set up with the first binding.
+func (s *Session) addBinding(b *Binding) error {
+	s.bindingsSubscriptionMu.Lock()
+	defer s.bindingsSubscriptionMu.Unlock()
+
+	s.bindingsMu.RLock()
+	_, exists := s.bindings[b.channel]
+	s.bindingsMu.RUnlock()
+
+	if exists {
+		return fmt.Errorf("binding %s already exists", b.Name)
+	}
+
+	if s.bindingsSubscription == nil {
+		remove := s.client.AddEventListener("script.message", s.handleScriptMessage)
+
+		subscription, err := s.NewSubscription([]string{"script.message"})
+		if err != nil {
+			remove()
+			return err
+		}
+
+		s.bindingsRemove = remove
+		s.bindingsSubscription = subscription
+	}
+
+	s.bindingsMu.Lock()
+	defer s.bindingsMu.Unlock()
+
+	if s.bindings == nil {
+		s.bindings = map[string]*Binding{}
+	}
+
+	s.bindings[b.channel] = b
+
+	return nil
+}
+
+// removeBinding unregisters the binding and unsubscribes from script.message with the last one.
+func (s *Session) removeBinding(b *Binding) error {
+	s.bindingsSubscriptionMu.Lock()
+	defer s.bindingsSubscriptionMu.Unlock()
+
+	s.bindingsMu.Lock()
+
+	if s.bindings[b.channel] != b {
+		s.bindingsMu.Unlock()
+		return nil
+	}
+
+	delete(s.bindings, b.channel)
+	last := len(s.bindings) == 0
+
+	s.bindingsMu.Unlock()
+
+	if !last || s.bindingsSubscription == nil {
+		return nil
+	}
+
+	s.bindingsRemove()
+	subscription := s.bindingsSubscription
+
+	s.bindingsRemove = nil
+	s.bindingsSubscription = nil
+
+	return subscription.Unsubscribe()
+}
+
+// handleScriptMessage routes script messages to their binding. Messages of other channels are
+// ignored and malformed calls are reported by the binding, as errors of event listeners are fatal.
+func (s *Session) handleScriptMessage(params json.RawMessage) error {
+	msg := &Message{}
+	if err := json.Unmarshal(params, msg); err != nil {
+		return nil // nolint nilerr
+	}
+
+	s.bindingsMu.RLock()
+	binding, ok := s.bindings[msg.Channel]
+	s.bindingsMu.RUnlock()
+
+	if !ok {
+		return nil
+	}
+
+	call, err := decodeBindingCall(msg.Data)
+	if err != nil {
+		binding.report(err)
+		return nil
+	}
+
+	// The binding may call back into the session, so it must not block the event loop.
+	go binding.invoke(msg.Source, call)
+
+	return nil
+}
+
+func decodeBindingCall(data json.RawMessage) (*bindingCall, error) {
+	var payload struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("invalid binding payload: %w", err)
+	}
+
+	if payload.Type != "string" {
+		return nil, fmt.Errorf("unexpected binding payload type: %s", payload.Type)
+	}
+
+	call := &bindingCall{}
+	if err := json.Unmarshal([]byte(payload.Value), call); err != nil {
+		return nil, fmt.Errorf("invalid binding call: %w", err)
+	}
+
+	return call, nil
+}
+
+func (b *Binding) invoke(source Source, call *bindingCall) {
+	result, err := b.fn(source, call.Args)
+
+	if !b.opts.ReturnResult {
+		return
+	}
+
+	res := bindingResult{Result: result}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	payload, err := json.Marshal(res)
+	if err != nil {
+		payload, _ = json.Marshal(bindingResult{Error: err.Error()})
+	}
+
+	if err := b.deliver(source, call.ID, string(payload)); err != nil {
+		b.report(fmt.Errorf("deliver result of binding %s: %w", b.Name, err))
+	}
+}
+
+func (b *Binding) report(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.errs = append(b.errs, err)
+}
+
+// Err returns the first error since the last call, either a malformed call from the page or a
+// result that could not be delivered, and resets the reported errors.
+func (b *Binding) Err() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.errs) == 0 {
+		return nil
+	}
+
+	err := b.errs[0]
+	b.errs = nil
+
+	return err
+}
+
+func (b *Binding) deliver(source Source, id int, payload string) error {
+	if source.Realm == "" {
+		return errors.New("binding call without source realm")
+	}
+
+	_, err := b.session.client.Call(context.Background(), "script.callFunction", map[string]interface{}{
+		"functionDeclaration": bindingDeliverFunction,
+		"awaitPromise":        false,
+		"target": map[string]interface{}{
+			"realm": source.Realm,
+		},
+		"arguments": []LocalValue{
+			NewLocalValue(b.Name),
+			NewLocalValue(id),
+			NewLocalValue(payload),
+		},
+	})
+
+	return err
+}
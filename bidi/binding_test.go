@@ -0,0 +1,138 @@
+package bidi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinding(t *testing.T) {
+	subscribe := subscriptionResult()
+	scripts := 0
+
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "script.callFunction" && strings.Contains(string(params), `"realm":"gone"`) {
+			return nil, errors.New("no such frame")
+		}
+
+		if method == "script.addPreloadScript" {
+			scripts++
+			return map[string]string{"script": fmt.Sprintf("ps-%d", scripts)}, nil
+		}
+
+		return subscribe(method, params)
+	})
+
+	add, err := session.AddBinding("add", func(source Source, args []json.RawMessage) (interface{}, error) {
+		var a, b int
+		if err := json.Unmarshal(args[0], &a); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(args[1], &b); err != nil {
+			return nil, err
+		}
+
+		return a + b, nil
+	}, func(o *BindingOptions) {
+		o.ReturnResult = true
+	})
+	assert.NoError(t, err)
+
+	fail, err := session.AddBinding("fail", func(source Source, args []json.RawMessage) (interface{}, error) {
+		return nil, errors.New("boom")
+	}, func(o *BindingOptions) {
+		o.ReturnResult = true
+	})
+	assert.NoError(t, err)
+
+	_, err = session.AddBinding("add", nil)
+	assert.Error(t, err)
+
+	// Bindings share one subscription and do not replace other script.message callbacks
+	assert.Len(t, remote.params("session.subscribe"), 1)
+
+	messages := make(chan string, 16)
+	session.client.CallbackEvent("script.message", func(params json.RawMessage) error {
+		messages <- string(params)
+		return nil
+	})
+
+	call := func(channel string, payload string) {
+		remote.emit("script.message", map[string]interface{}{
+			"channel": channel,
+			"data":    map[string]string{"type": "string", "value": payload},
+			"source":  Source{Realm: "realm1", Context: "tab1"},
+		})
+	}
+
+	// Malformed calls are reported by the binding
+	remote.emit("script.message", map[string]interface{}{
+		"channel": "gowebdriver-binding-add",
+		"data":    map[string]string{"type": "number"},
+		"source":  Source{Realm: "realm1", Context: "tab1"},
+	})
+
+	assert.Eventually(t, func() bool {
+		err = add.Err()
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.EqualError(t, err, "unexpected binding payload type: number")
+	assert.Len(t, messages, 1)
+
+	call("gowebdriver-binding-add", `{"id":1,"args":[1,2]}`)
+	call("gowebdriver-binding-fail", `{"id":2,"args":[]}`)
+	call("other", `{"id":3,"args":[]}`)
+
+	assert.Eventually(t, func() bool {
+		return len(remote.params("script.callFunction")) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	delivered := func(name string, id int, payload string) string {
+		data, err := json.Marshal(map[string]interface{}{
+			"functionDeclaration": bindingDeliverFunction,
+			"awaitPromise":        false,
+			"target":              map[string]interface{}{"realm": "realm1"},
+			"arguments":           []LocalValue{NewLocalValue(name), NewLocalValue(id), NewLocalValue(payload)},
+		})
+		assert.NoError(t, err)
+
+		return string(data)
+	}
+
+	// Results are delivered concurrently
+	assert.ElementsMatch(t, []string{
+		delivered("add", 1, `{"result":3}`),
+		delivered("fail", 2, `{"error":"boom"}`),
+	}, remote.params("script.callFunction"))
+
+	// Results that cannot be delivered are reported
+	remote.emit("script.message", map[string]interface{}{
+		"channel": "gowebdriver-binding-add",
+		"data":    map[string]string{"type": "string", "value": `{"id":4,"args":[1,1]}`},
+		"source":  Source{Realm: "gone", Context: "tab1"},
+	})
+
+	assert.Eventually(t, func() bool {
+		err = add.Err()
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.ErrorContains(t, err, "no such frame")
+
+	// Removing the last binding unsubscribes
+	assert.NoError(t, add.Remove())
+	assert.Empty(t, remote.params("session.unsubscribe"))
+
+	assert.NoError(t, fail.Remove())
+	assert.Equal(t, []string{`{"subscriptions":["sub-1"]}`}, remote.params("session.unsubscribe"))
+	assert.Equal(t, []string{`{"script":"ps-1"}`, `{"script":"ps-2"}`}, remote.params("script.removePreloadScript"))
+
+	_, err = session.AddBinding("add", nil)
+	assert.NoError(t, err)
+	assert.Len(t, remote.params("session.subscribe"), 2)
+}
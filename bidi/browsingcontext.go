@@ -3,6 +3,7 @@ package bidi
 import (
 	"context"
 	"encoding/json"
+	"sync"
 )
 
 type BrowsingContextType string
@@ -13,9 +14,13 @@ const (
 )
 
 type BrowsingContext struct {
-	ID     string              `json:"context"`
-	Type   BrowsingContextType `json:"-"`
-	client *Client             `json:"-"`
+	ID       string              `json:"context"`
+	Type     BrowsingContextType `json:"-"`
+	client   *Client             `json:"-"`
+	mu       sync.Mutex
+	handlers map[string]func()
+	framesMu sync.Mutex
+	frames   map[string]bool // known contexts and whether they are descendants
 }
 
 // func (b *BrowsingContext) CaptureScreenshot() ([]byte, error) {
@@ -39,9 +44,130 @@ func (b *BrowsingContext) Close() error {
 		"context": b.ID,
 	})
 
+	b.removeHandlers()
+
 	return err
 }
 
+// Subscribe subscribes to the events of this browsing context and its child frames only.
+func (b *BrowsingContext) Subscribe(events []string) (*Subscription, error) {
+	return subscribe(b.client, events, func(o *SubscribeOptions) {
+		o.Contexts = []string{b.ID}
+	})
+}
+
+// on registers a callback for the events of this browsing context and its child frames, like
+// Subscribe. A previous callback for the method is replaced.
+func (b *BrowsingContext) on(method string, cb EventCallback) {
+	remove := b.client.AddEventListener(method, func(params json.RawMessage) error {
+		if !b.contains(eventContext(params)) {
+			return nil
+		}
+
+		return cb(params)
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handlers == nil {
+		b.handlers = map[string]func(){}
+	}
+
+	if prev, ok := b.handlers[method]; ok {
+		prev()
+	}
+
+	b.handlers[method] = remove
+}
+
+// contains reports whether id is this browsing context or one of its descendants. The descendants
+// are looked up with browsingContext.getTree when an unknown context is seen for the first time.
+func (b *BrowsingContext) contains(id string) bool {
+	if id == b.ID {
+		return true
+	}
+
+	if id == "" {
+		return false
+	}
+
+	b.framesMu.Lock()
+	defer b.framesMu.Unlock()
+
+	if descendant, ok := b.frames[id]; ok {
+		return descendant
+	}
+
+	if b.frames == nil {
+		b.frames = map[string]bool{}
+	}
+
+	if descendants, err := b.descendants(); err == nil {
+		for _, d := range descendants {
+			b.frames[d] = true
+		}
+	}
+
+	// The parent of a context never changes, so a context outside the tree stays outside.
+	if !b.frames[id] {
+		b.frames[id] = false
+	}
+
+	return b.frames[id]
+}
+
+// descendants returns the IDs of the contexts below this browsing context.
+func (b *BrowsingContext) descendants() ([]string, error) {
+	data, err := b.client.Call(context.Background(), "browsingContext.getTree", map[string]interface{}{
+		"root": b.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type info struct {
+		Context  string `json:"context"`
+		Children []info `json:"children"`
+	}
+
+	tree := struct {
+		Contexts []info `json:"contexts"`
+	}{}
+
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	var walk func(contexts []info)
+	walk = func(contexts []info) {
+		for _, c := range contexts {
+			if c.Context != b.ID {
+				ids = append(ids, c.Context)
+			}
+
+			walk(c.Children)
+		}
+	}
+
+	walk(tree.Contexts)
+
+	return ids, nil
+}
+
+func (b *BrowsingContext) removeHandlers() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, remove := range b.handlers {
+		remove()
+	}
+
+	b.handlers = nil
+}
+
 func (b *BrowsingContext) HandleUserPrompt(accept bool, userText string) error {
 	_, err := b.client.Call(context.Background(), "browsingContext.handleUserPrompt", map[string]interface{}{
 		"context":  b.ID,
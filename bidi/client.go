@@ -3,6 +3,7 @@ package bidi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -44,33 +45,72 @@ type Event struct {
 // EventCallback represents a callback event, associated with a method.
 type EventCallback func(params json.RawMessage) error
 
+// Transport carries messages between the client and the remote end.
+type Transport interface {
+	// Read blocks until the next message is received
+	Read(ctx context.Context) ([]byte, error)
+
+	// Write sends a message
+	Write(ctx context.Context, p []byte) error
+
+	// Close closes the transport. Pending reads return an error.
+	Close() error
+}
+
 type Client struct {
 	count     uint64
 	pending   sync.Map    // pending requests
-	event     chan *Event // events from browser
-	ws        *WebSocket
+	events    *eventQueue // events from browser
+	transport Transport
+	mu        sync.RWMutex
 	callbacks map[string]EventCallback
+	listeners map[string]map[uint64]EventCallback
 }
 
-func NewBiDiClient() *Client {
+// NewBiDiClient returns a client that connects to the remote end via websocket when it is started.
+func NewBiDiClient(optFns ...func(o *WebSocketOptions)) *Client {
+	return newClient(NewWebSocket(optFns...))
+}
+
+// NewClient returns a client that communicates over a connected transport. The client starts
+// reading messages immediately and must not be started.
+func NewClient(transport Transport) *Client {
+	c := newClient(transport)
+	c.run()
+
+	return c
+}
+
+func newClient(transport Transport) *Client {
 	return &Client{
-		event:     make(chan *Event),
-		ws:        &WebSocket{},
+		events:    newEventQueue(),
+		transport: transport,
 		callbacks: map[string]EventCallback{},
+		listeners: map[string]map[uint64]EventCallback{},
 	}
 }
 
+// Start connects the websocket transport to wsURL and starts reading messages.
 func (c *Client) Start(wsURL string, header http.Header) error {
-	if err := c.ws.Connect(context.Background(), wsURL, header); err != nil {
+	ws, ok := c.transport.(*WebSocket)
+	if !ok {
+		return errors.New("client transport is not a websocket")
+	}
+
+	if err := ws.Connect(context.Background(), wsURL, header); err != nil {
 		return err
 	}
 
-	go c.processEvents()
-	go c.readMessages()
+	c.run()
 
 	return nil
 }
 
+func (c *Client) run() {
+	go c.processEvents()
+	go c.readMessages()
+}
+
 type result struct {
 	msg json.RawMessage
 	err error
@@ -103,7 +143,7 @@ func (c *Client) Call(ctx context.Context, method string, params interface{}) ([
 		return nil, err
 	}
 
-	if err := c.ws.Write(context.Background(), data); err != nil {
+	if err := c.transport.Write(context.Background(), data); err != nil {
 		return nil, err
 	}
 
@@ -116,19 +156,44 @@ func (c *Client) Call(ctx context.Context, method string, params interface{}) ([
 }
 
 func (c *Client) Close() error {
-	return c.ws.Close()
+	return c.transport.Close()
 }
 
 func (c *Client) CallbackEvent(method string, cb EventCallback) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.callbacks[method] = cb
 }
 
+// AddEventListener registers an additional callback for method. Unlike CallbackEvent it does not
+// replace other callbacks. The returned function removes the listener.
+func (c *Client) AddEventListener(method string, cb EventCallback) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := c.newID()
+
+	if _, ok := c.listeners[method]; !ok {
+		c.listeners[method] = map[uint64]EventCallback{}
+	}
+
+	c.listeners[method][id] = cb
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.listeners[method], id)
+	}
+}
+
 // Read messages coming from the browser via the websocket.
 func (c *Client) readMessages() {
-	defer close(c.event)
+	defer c.events.close()
 
 	for {
-		data, err := c.ws.Read(context.Background())
+		data, err := c.transport.Read(context.Background())
 		if err != nil {
 			c.pending.Range(func(_, val interface{}) bool {
 				val.(func(result))(result{err: err})
@@ -152,7 +217,7 @@ func (c *Client) readMessages() {
 				panic(err)
 			}
 
-			c.event <- &evt
+			c.events.push(&evt)
 
 			continue
 		}
@@ -183,15 +248,87 @@ func (c *Client) readMessages() {
 
 // Process events coming from the browser via the websocket.
 func (c *Client) processEvents() {
-	for event := range c.event {
-		if cb, ok := c.callbacks[event.Method]; ok {
+	for {
+		event, ok := c.events.pop()
+		if !ok {
+			return
+		}
+
+		c.mu.RLock()
+		cb, ok := c.callbacks[event.Method]
+
+		listeners := make([]EventCallback, 0, len(c.listeners[event.Method]))
+		for _, l := range c.listeners[event.Method] {
+			listeners = append(listeners, l)
+		}
+		c.mu.RUnlock()
+
+		if ok {
 			if err := cb(event.Params); err != nil {
 				panic(err)
 			}
 		}
+
+		for _, l := range listeners {
+			if err := l(event.Params); err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
 func (c *Client) newID() uint64 {
 	return atomic.AddUint64(&c.count, 1)
 }
+
+// eventQueue is an unbounded queue of events. Reading messages never blocks on event callbacks, so
+// callbacks may issue commands and wait for their results.
+type eventQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  []*Event
+	closed bool
+}
+
+func newEventQueue() *eventQueue {
+	q := &eventQueue{}
+	q.cond = sync.NewCond(&q.mu)
+
+	return q
+}
+
+func (q *eventQueue) push(e *Event) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.items = append(q.items, e)
+	q.cond.Signal()
+}
+
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// pop blocks until an event is available. It returns false once the queue is closed and drained.
+func (q *eventQueue) pop() (*Event, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+
+	if len(q.items) == 0 {
+		return nil, false
+	}
+
+	e := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+
+	return e, true
+}
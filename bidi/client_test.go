@@ -0,0 +1,79 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventQueue(t *testing.T) {
+	q := newEventQueue()
+
+	popped := make(chan string)
+
+	go func() {
+		for {
+			e, ok := q.pop()
+			if !ok {
+				close(popped)
+				return
+			}
+
+			popped <- e.Method
+		}
+	}()
+
+	// pop blocks until an event is pushed
+	q.push(&Event{Method: "a"})
+	assert.Equal(t, "a", <-popped)
+
+	// Events pushed before close are drained in order
+	q.push(&Event{Method: "b"})
+	q.push(&Event{Method: "c"})
+	q.close()
+
+	var methods []string
+	for m := range popped {
+		methods = append(methods, m)
+	}
+
+	assert.Equal(t, []string{"b", "c"}, methods)
+
+	_, ok := q.pop()
+	assert.False(t, ok)
+}
+
+func TestClientCallFromEvent(t *testing.T) {
+	session, remote := newTestSession(t, nil)
+
+	results := make(chan error, 2)
+
+	remove := session.client.AddEventListener("log.entryAdded", func(params json.RawMessage) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := session.client.Call(ctx, "session.status", map[string]interface{}{})
+		results <- err
+
+		return nil
+	})
+	defer remove()
+
+	// The second event is queued while the callback of the first waits for its result.
+	remote.emit("log.entryAdded", map[string]string{})
+	remote.emit("log.entryAdded", map[string]string{})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-results:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("callback deadlocked")
+		}
+	}
+
+	assert.Len(t, remote.params("session.status"), 2)
+}
@@ -0,0 +1,242 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+type DownloadBehaviorType string
+
+const (
+	DownloadBehaviorTypeAllowed DownloadBehaviorType = "allowed"
+	DownloadBehaviorTypeDenied  DownloadBehaviorType = "denied"
+)
+
+type DownloadBehavior struct {
+	Type DownloadBehaviorType `json:"type"`
+
+	// Folder downloads are saved to. Only used with DownloadBehaviorTypeAllowed.
+	DestinationFolder string `json:"destinationFolder,omitempty"`
+}
+
+// SetDownloadBehavior configures how downloads are handled. A nil behavior restores the default
+// behavior of the browser. If no user contexts are given, the behavior applies to all of them.
+func (s *Session) SetDownloadBehavior(behavior *DownloadBehavior, userContexts ...string) error {
+	params := map[string]interface{}{
+		"downloadBehavior": behavior,
+	}
+
+	if len(userContexts) > 0 {
+		params["userContexts"] = userContexts
+	}
+
+	_, err := s.client.Call(context.Background(), "browser.setDownloadBehavior", params)
+
+	return err
+}
+
+type DownloadWillBeginEvent struct {
+	Context           string    `json:"context"`
+	Navigation        string    `json:"navigation"`
+	Timestamp         Timestamp `json:"timestamp"`
+	URL               string    `json:"url"`
+	SuggestedFilename string    `json:"suggestedFilename"`
+}
+
+type DownloadStatus string
+
+const (
+	DownloadStatusCanceled DownloadStatus = "canceled"
+	DownloadStatusComplete DownloadStatus = "complete"
+)
+
+type DownloadEndEvent struct {
+	Context    string         `json:"context"`
+	Navigation string         `json:"navigation"`
+	Timestamp  Timestamp      `json:"timestamp"`
+	URL        string         `json:"url"`
+	Status     DownloadStatus `json:"status"`
+	Filepath   string         `json:"filepath"`
+}
+
+// OnDownloadWillBegin registers a handler for browsingContext.downloadWillBegin.
+func (s *Session) OnDownloadWillBegin(handler func(event *DownloadWillBeginEvent) error) {
+	s.client.CallbackEvent("browsingContext.downloadWillBegin", func(params json.RawMessage) error {
+		e := &DownloadWillBeginEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnDownloadEnd registers a handler for browsingContext.downloadEnd.
+func (s *Session) OnDownloadEnd(handler func(event *DownloadEndEvent) error) {
+	s.client.CallbackEvent("browsingContext.downloadEnd", func(params json.RawMessage) error {
+		e := &DownloadEndEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnDownloadWillBegin registers a handler for browsingContext.downloadWillBegin events of this browsing context.
+func (b *BrowsingContext) OnDownloadWillBegin(handler func(event *DownloadWillBeginEvent) error) {
+	b.on("browsingContext.downloadWillBegin", func(params json.RawMessage) error {
+		e := &DownloadWillBeginEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnDownloadEnd registers a handler for browsingContext.downloadEnd events of this browsing context.
+func (b *BrowsingContext) OnDownloadEnd(handler func(event *DownloadEndEvent) error) {
+	b.on("browsingContext.downloadEnd", func(params json.RawMessage) error {
+		e := &DownloadEndEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// Download is a completed download.
+type Download struct {
+	URL               string
+	SuggestedFilename string
+	Path              string
+	Data              []byte
+}
+
+// WaitForDownload runs trigger, for example a click on a download link, and waits until the
+// download it starts in this browsing context or one of its frames has completed. The first
+// download that begins after trigger was called is taken. Downloads must be allowed with
+// SetDownloadBehavior.
+func (b *BrowsingContext) WaitForDownload(ctx context.Context, trigger func() error) (*Download, error) {
+	var (
+		mu        sync.Mutex
+		triggered bool
+		started   *DownloadWillBeginEvent
+		ended     = map[string]*DownloadEndEvent{} // by navigation
+		notify    = make(chan struct{}, 1)
+	)
+
+	signal := func() {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	removeBegin := b.client.AddEventListener("browsingContext.downloadWillBegin", func(params json.RawMessage) error {
+		if !b.contains(eventContext(params)) {
+			return nil
+		}
+
+		e := &DownloadWillBeginEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		if triggered && started == nil {
+			started = e
+		}
+		mu.Unlock()
+
+		signal()
+
+		return nil
+	})
+	defer removeBegin()
+
+	removeEnd := b.client.AddEventListener("browsingContext.downloadEnd", func(params json.RawMessage) error {
+		if !b.contains(eventContext(params)) {
+			return nil
+		}
+
+		e := &DownloadEndEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		ended[e.Navigation] = e
+		mu.Unlock()
+
+		signal()
+
+		return nil
+	})
+	defer removeEnd()
+
+	subscription, err := b.Subscribe([]string{"browsingContext.downloadWillBegin", "browsingContext.downloadEnd"})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = subscription.Unsubscribe() }()
+
+	// Downloads that begin before the trigger are not caused by it.
+	mu.Lock()
+	triggered = true
+	mu.Unlock()
+
+	if err := trigger(); err != nil {
+		return nil, err
+	}
+
+	for {
+		mu.Lock()
+
+		var e *DownloadEndEvent
+		if started != nil {
+			e = ended[started.Navigation]
+		}
+
+		mu.Unlock()
+
+		if e != nil {
+			return newDownload(started, e)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+func newDownload(started *DownloadWillBeginEvent, e *DownloadEndEvent) (*Download, error) {
+	if e.Status != DownloadStatusComplete {
+		return nil, fmt.Errorf("download of %s %s", e.URL, e.Status)
+	}
+
+	if e.Filepath == "" {
+		return nil, errors.New("download completed without file path")
+	}
+
+	data, err := os.ReadFile(e.Filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Download{
+		URL:               started.URL,
+		SuggestedFilename: started.SuggestedFilename,
+		Path:              e.Filepath,
+		Data:              data,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package bidi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForDownload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.csv")
+	assert.NoError(t, os.WriteFile(path, []byte("a,b"), 0600))
+
+	session, remote := newTestSession(t, subscriptionResult())
+	tab := &BrowsingContext{ID: "tab1", client: session.client}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	download, err := tab.WaitForDownload(ctx, func() error {
+		// A concurrent download in another tab is not paired with the trigger
+		remote.emit("browsingContext.downloadWillBegin", map[string]interface{}{
+			"context": "tab2", "navigation": "n2", "url": "https://example.com/other.csv", "suggestedFilename": "other.csv",
+		})
+		remote.emit("browsingContext.downloadWillBegin", map[string]interface{}{
+			"context": "tab1", "navigation": "n1", "url": "https://example.com/report.csv", "suggestedFilename": "report.csv",
+		})
+		remote.emit("browsingContext.downloadEnd", map[string]interface{}{
+			"context": "tab2", "navigation": "n2", "status": "complete", "filepath": "/nonexistent",
+		})
+		// End events of other downloads in the tab do not crowd out the one of the trigger
+		for i := 0; i < 20; i++ {
+			remote.emit("browsingContext.downloadEnd", map[string]interface{}{
+				"context": "tab1", "navigation": fmt.Sprintf("old-%d", i), "status": "canceled",
+			})
+		}
+
+		remote.emit("browsingContext.downloadEnd", map[string]interface{}{
+			"context": "tab1", "navigation": "n1", "status": "complete", "filepath": path,
+		})
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &Download{
+		URL:               "https://example.com/report.csv",
+		SuggestedFilename: "report.csv",
+		Path:              path,
+		Data:              []byte("a,b"),
+	}, download)
+
+	assert.Equal(t, []string{
+		`{"contexts":["tab1"],"events":["browsingContext.downloadWillBegin","browsingContext.downloadEnd"]}`,
+	}, remote.params("session.subscribe"))
+	assert.Equal(t, []string{`{"subscriptions":["sub-1"]}`}, remote.params("session.unsubscribe"))
+}
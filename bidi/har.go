@@ -0,0 +1,407 @@
+package bidi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HAR is the root of a HTTP Archive.
+//
+// See: http://www.softwareishard.com/blog/har-12-spec/
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+type HARLog struct {
+	Version string      `json:"version"`
+	Creator HARCreator  `json:"creator"`
+	Entries []*HAREntry `json:"entries"`
+}
+
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type HAREntry struct {
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         HARRequest  `json:"request"`
+	Response        HARResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         HARTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+type HARNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type HARRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	QueryString []HARNameValue `json:"queryString"`
+	HeadersSize int64          `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type HARResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []HARNameValue `json:"cookies"`
+	Headers     []HARNameValue `json:"headers"`
+	Content     HARContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int64          `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type HARContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// HARTimings holds the phases of a request in milliseconds. Phases that do not apply are -1.
+type HARTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+// HARRecorder records the network traffic of a browsing context, including its child frames.
+type HARRecorder struct {
+	bc           *BrowsingContext
+	mu           sync.Mutex
+	entries      []*HAREntry
+	pending      map[string]*HAREntry
+	remove       []func()
+	subscription *Subscription
+}
+
+var harEvents = []string{
+	"network.beforeRequestSent",
+	"network.responseCompleted",
+	"network.fetchError",
+}
+
+// NewHARRecorder subscribes to the network events of the browsing context and starts recording.
+func NewHARRecorder(bc *BrowsingContext) (*HARRecorder, error) {
+	r := &HARRecorder{
+		bc:      bc,
+		pending: map[string]*HAREntry{},
+	}
+
+	r.remove = []func(){
+		bc.client.AddEventListener("network.beforeRequestSent", r.onBeforeRequestSent),
+		bc.client.AddEventListener("network.responseCompleted", r.onResponseCompleted),
+		bc.client.AddEventListener("network.fetchError", r.onFetchError),
+	}
+
+	subscription, err := bc.Subscribe(harEvents)
+	if err != nil {
+		r.Stop()
+		return nil, err
+	}
+
+	r.subscription = subscription
+
+	return r, nil
+}
+
+// Stop stops recording. Requests that have not completed yet are dropped.
+func (r *HARRecorder) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.subscription != nil {
+		_ = r.subscription.Unsubscribe()
+	}
+
+	r.subscription = nil
+
+	for _, fn := range r.remove {
+		fn()
+	}
+
+	r.remove = nil
+	r.pending = map[string]*HAREntry{}
+}
+
+// HAR returns the recorded entries as a HTTP Archive.
+func (r *HARRecorder) HAR() *HAR {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := make([]*HAREntry, len(r.entries))
+	copy(entries, r.entries)
+
+	return &HAR{
+		Log: HARLog{
+			Version: "1.2",
+			Creator: HARCreator{
+				Name:    "gowebdriver",
+				Version: "1.0",
+			},
+			Entries: entries,
+		},
+	}
+}
+
+// WriteTo writes the recorded HAR as JSON to w.
+func (r *HARRecorder) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(r.HAR(), "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+
+	return int64(n), err
+}
+
+// WriteFile writes the recorded HAR to the named file.
+func (r *HARRecorder) WriteFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.WriteTo(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func (r *HARRecorder) onBeforeRequestSent(params json.RawMessage) error {
+	e := &BeforeRequestSentEvent{}
+	if err := json.Unmarshal(params, e); err != nil {
+		return err
+	}
+
+	if !r.bc.contains(e.Context) {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pending[pendingKey(&e.NetworkEvent)] = &HAREntry{
+		StartedDateTime: e.Timestamp.Time,
+		Request:         newHARRequest(&e.Request),
+		Timings:         HARTimings{Blocked: -1, DNS: -1, Connect: -1, Send: 0, Wait: -1, Receive: -1, SSL: -1},
+	}
+
+	return nil
+}
+
+func (r *HARRecorder) onResponseCompleted(params json.RawMessage) error {
+	e := &ResponseEvent{}
+	if err := json.Unmarshal(params, e); err != nil {
+		return err
+	}
+
+	if !r.bc.contains(e.Context) {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.take(&e.NetworkEvent)
+	if !ok {
+		return nil
+	}
+
+	// Header and body sizes are only known once the request has been sent.
+	entry.Request = newHARRequest(&e.Request)
+	entry.Request.HTTPVersion = e.Response.Protocol
+	entry.Response = newHARResponse(&e.Response)
+	entry.Timings = newHARTimings(&e.Request.Timings)
+	entry.Time = entry.Timings.total()
+
+	r.entries = append(r.entries, entry)
+
+	return nil
+}
+
+func (r *HARRecorder) onFetchError(params json.RawMessage) error {
+	e := &FetchErrorEvent{}
+	if err := json.Unmarshal(params, e); err != nil {
+		return err
+	}
+
+	if !r.bc.contains(e.Context) {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.take(&e.NetworkEvent)
+	if !ok {
+		return nil
+	}
+
+	entry.Response = HARResponse{
+		Cookies:     []HARNameValue{},
+		Headers:     []HARNameValue{},
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+	entry.Error = e.ErrorText
+
+	r.entries = append(r.entries, entry)
+
+	return nil
+}
+
+func (r *HARRecorder) take(e *NetworkEvent) (*HAREntry, bool) {
+	key := pendingKey(e)
+
+	entry, ok := r.pending[key]
+	if ok {
+		delete(r.pending, key)
+	}
+
+	return entry, ok
+}
+
+// pendingKey identifies a request. Redirects reuse the request id, so the redirect count is part of the key.
+func pendingKey(e *NetworkEvent) string {
+	return fmt.Sprintf("%s/%d", e.Request.ID, e.RedirectCount)
+}
+
+func newHARRequest(req *RequestData) HARRequest {
+	r := HARRequest{
+		Method:      req.Method,
+		URL:         req.URL,
+		Cookies:     []HARNameValue{},
+		Headers:     newHARHeaders(req.Headers),
+		QueryString: []HARNameValue{},
+		HeadersSize: sizeOrUnknown(req.HeadersSize),
+		BodySize:    sizeOrUnknown(req.BodySize),
+	}
+
+	for _, c := range req.Cookies {
+		r.Cookies = append(r.Cookies, HARNameValue{Name: c.Name, Value: c.Value.String()})
+	}
+
+	// Parameters are kept in the order they appear in the URL.
+	if u, err := url.Parse(req.URL); err == nil && u.RawQuery != "" {
+		for _, pair := range strings.Split(u.RawQuery, "&") {
+			name, value, _ := strings.Cut(pair, "=")
+			name, _ = url.QueryUnescape(name)
+			value, _ = url.QueryUnescape(value)
+
+			r.QueryString = append(r.QueryString, HARNameValue{Name: name, Value: value})
+		}
+	}
+
+	return r
+}
+
+func newHARResponse(res *ResponseData) HARResponse {
+	r := HARResponse{
+		Status:      res.Status,
+		StatusText:  res.StatusText,
+		HTTPVersion: res.Protocol,
+		Cookies:     []HARNameValue{},
+		Headers:     newHARHeaders(res.Headers),
+		Content: HARContent{
+			Size:     res.Content.Size,
+			MimeType: res.MimeType,
+		},
+		HeadersSize: sizeOrUnknown(res.HeadersSize),
+		BodySize:    sizeOrUnknown(res.BodySize),
+	}
+
+	for _, h := range r.Headers {
+		if strings.EqualFold(h.Name, "location") {
+			r.RedirectURL = h.Value
+		}
+	}
+
+	return r
+}
+
+func newHARHeaders(headers []Header) []HARNameValue {
+	values := make([]HARNameValue, 0, len(headers))
+	for _, h := range headers {
+		values = append(values, HARNameValue{Name: h.Name, Value: h.Value.String()})
+	}
+
+	return values
+}
+
+func newHARTimings(t *FetchTimingInfo) HARTimings {
+	timings := HARTimings{
+		Blocked: -1,
+		DNS:     phase(t.DNSStart, t.DNSEnd),
+		Connect: phase(t.ConnectStart, t.ConnectEnd),
+		Send:    0,
+		Wait:    phase(t.RequestStart, t.ResponseStart),
+		Receive: phase(t.ResponseStart, t.ResponseEnd),
+		SSL:     phase(t.TLSStart, t.ConnectEnd),
+	}
+
+	start := t.DNSStart
+	if start == 0 {
+		start = t.ConnectStart
+	}
+
+	if start == 0 {
+		start = t.RequestStart
+	}
+
+	timings.Blocked = phase(t.FetchStart, start)
+
+	return timings
+}
+
+func phase(start, end float64) float64 {
+	if start <= 0 || end <= 0 || end < start {
+		return -1
+	}
+
+	return end - start
+}
+
+func (t HARTimings) total() float64 {
+	total := 0.0
+
+	// SSL is part of connect and therefore not added.
+	for _, v := range []float64{t.Blocked, t.DNS, t.Connect, t.Send, t.Wait, t.Receive} {
+		if v > 0 {
+			total += v
+		}
+	}
+
+	return total
+}
+
+func sizeOrUnknown(size *int64) int64 {
+	if size == nil {
+		return -1
+	}
+
+	return *size
+}
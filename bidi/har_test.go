@@ -0,0 +1,113 @@
+package bidi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHARRecorder(t *testing.T) {
+	r := &HARRecorder{
+		bc:      &BrowsingContext{ID: "ctx", frames: map[string]bool{"other": false}},
+		pending: map[string]*HAREntry{},
+	}
+
+	assert.NoError(t, r.onBeforeRequestSent(json.RawMessage(`{
+		"context": "ctx",
+		"redirectCount": 0,
+		"timestamp": 1700000000000,
+		"request": {"request": "1", "url": "https://example.com/?q=go", "method": "GET", "headers": [], "cookies": []}
+	}`)))
+
+	assert.NoError(t, r.onBeforeRequestSent(json.RawMessage(`{
+		"context": "other",
+		"request": {"request": "2", "url": "https://example.org/", "method": "GET"}
+	}`)))
+
+	assert.NoError(t, r.onResponseCompleted(json.RawMessage(`{
+		"context": "ctx",
+		"redirectCount": 0,
+		"timestamp": 1700000000100,
+		"request": {
+			"request": "1", "url": "https://example.com/?q=go", "method": "GET",
+			"headers": [{"name": "Accept", "value": {"type": "string", "value": "*/*"}}],
+			"cookies": [], "headersSize": 120, "bodySize": 0,
+			"timings": {"fetchStart": 1, "dnsStart": 2, "dnsEnd": 5, "connectStart": 5, "connectEnd": 10, "requestStart": 10, "responseStart": 30, "responseEnd": 40}
+		},
+		"response": {
+			"url": "https://example.com/?q=go", "protocol": "http/1.1", "status": 200, "statusText": "OK",
+			"headers": [{"name": "Content-Type", "value": {"type": "string", "value": "text/html"}}],
+			"mimeType": "text/html", "headersSize": 80, "bodySize": 512, "content": {"size": 1024}
+		}
+	}`)))
+
+	har := r.HAR()
+	assert.Equal(t, "1.2", har.Log.Version)
+	assert.Len(t, har.Log.Entries, 1)
+
+	entry := har.Log.Entries[0]
+	assert.Equal(t, int64(1700000000000), entry.StartedDateTime.UnixMilli())
+	assert.Equal(t, "http/1.1", entry.Request.HTTPVersion)
+	assert.Equal(t, []HARNameValue{{Name: "q", Value: "go"}}, entry.Request.QueryString)
+	assert.Equal(t, []HARNameValue{{Name: "Accept", Value: "*/*"}}, entry.Request.Headers)
+	assert.Equal(t, 200, entry.Response.Status)
+	assert.Equal(t, int64(1024), entry.Response.Content.Size)
+	assert.Equal(t, HARTimings{Blocked: 1, DNS: 3, Connect: 5, Send: 0, Wait: 20, Receive: 10, SSL: -1}, entry.Timings)
+	assert.Equal(t, float64(39), entry.Time)
+}
+
+func TestHARRecorderEvents(t *testing.T) {
+	subscribe := subscriptionResult()
+
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "browsingContext.getTree" {
+			return json.RawMessage(`{"contexts":[{"context":"tab1","children":[{"context":"frame1","children":[]}]}]}`), nil
+		}
+
+		return subscribe(method, params)
+	})
+
+	r, err := NewHARRecorder(&BrowsingContext{ID: "tab1", client: session.client})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`{"contexts":["tab1"],"events":["network.beforeRequestSent","network.responseCompleted","network.fetchError"]}`,
+	}, remote.params("session.subscribe"))
+
+	request := func(context, id, url string) {
+		req := map[string]interface{}{"request": id, "url": url, "method": "GET", "headers": []string{}, "cookies": []string{}}
+
+		remote.emit("network.beforeRequestSent", map[string]interface{}{
+			"context": context, "redirectCount": 0, "timestamp": 1700000000000, "request": req,
+		})
+		remote.emit("network.responseCompleted", map[string]interface{}{
+			"context": context, "redirectCount": 0, "timestamp": 1700000000100, "request": req,
+			"response": map[string]interface{}{"url": url, "protocol": "http/1.1", "status": 200, "headers": []string{}},
+		})
+	}
+
+	request("tab1", "1", "https://example.com/")
+	request("frame1", "2", "https://example.com/frame")
+	request("tab2", "3", "https://example.org/")
+
+	// Requests of child frames are recorded, requests of other tabs are not
+	assert.Eventually(t, func() bool {
+		return len(r.HAR().Log.Entries) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var urls []string
+	for _, entry := range r.HAR().Log.Entries {
+		urls = append(urls, entry.Request.URL)
+	}
+
+	assert.Equal(t, []string{"https://example.com/", "https://example.com/frame"}, urls)
+
+	r.Stop()
+	assert.Equal(t, []string{`{"subscriptions":["sub-1"]}`}, remote.params("session.unsubscribe"))
+
+	request("tab1", "4", "https://example.com/stopped")
+	assert.Never(t, func() bool {
+		return len(r.HAR().Log.Entries) != 2
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
@@ -38,8 +38,8 @@ type GenericLogEntry struct {
 
 type ConsoleLogEntry struct {
 	baseLogEntry
-	Method string      `json:"method"`
-	Args   interface{} `json:"args"`
+	Method string         `json:"method"`
+	Args   []*RemoteValue `json:"args"`
 }
 
 type JavascriptLogEntry struct {
@@ -53,64 +53,70 @@ type OnLogEntryHandler struct {
 }
 
 func (s *Session) OnLogEntryAdded(handler *OnLogEntryHandler) {
-	s.client.CallbackEvent("log.entryAdded", func(params json.RawMessage) error {
-		type entry struct {
-			Type LogType `json:"type"`
-		}
+	s.client.CallbackEvent("log.entryAdded", handler.callback)
+}
 
-		e := &entry{}
-		if err := json.Unmarshal(params, &e); err != nil {
-			return err
-		}
+// OnLogEntryAdded registers a handler for log.entryAdded events of this browsing context.
+func (b *BrowsingContext) OnLogEntryAdded(handler *OnLogEntryHandler) {
+	b.on("log.entryAdded", handler.callback)
+}
 
-		switch e.Type {
-		case LogTypeText:
-			if handler.LogTypeTextHandlerFunc != nil {
-				e := &GenericLogEntry{}
-				if err := json.Unmarshal(params, &e); err != nil {
-					return err
-				}
+func (handler *OnLogEntryHandler) callback(params json.RawMessage) error {
+	type entry struct {
+		Type LogType `json:"type"`
+	}
+
+	e := &entry{}
+	if err := json.Unmarshal(params, &e); err != nil {
+		return err
+	}
 
-				return handler.LogTypeTextHandlerFunc(e)
+	switch e.Type {
+	case LogTypeText:
+		if handler.LogTypeTextHandlerFunc != nil {
+			e := &GenericLogEntry{}
+			if err := json.Unmarshal(params, &e); err != nil {
+				return err
 			}
-		case LogTypeConsole:
-			if handler.LogTypeConsoleHandlerFunc != nil {
-				e := &ConsoleLogEntry{}
-				if err := json.Unmarshal(params, &e); err != nil {
-					return err
-				}
-
-				return handler.LogTypeConsoleHandlerFunc(e)
+
+			return handler.LogTypeTextHandlerFunc(e)
+		}
+	case LogTypeConsole:
+		if handler.LogTypeConsoleHandlerFunc != nil {
+			e := &ConsoleLogEntry{}
+			if err := json.Unmarshal(params, &e); err != nil {
+				return err
 			}
-		case LogTypeJavascript:
-			if handler.LogTypeJavascriptHandlerFunc != nil {
-				e := &JavascriptLogEntry{}
-				if err := json.Unmarshal(params, &e); err != nil {
-					return err
-				}
-
-				return handler.LogTypeJavascriptHandlerFunc(e)
+
+			return handler.LogTypeConsoleHandlerFunc(e)
+		}
+	case LogTypeJavascript:
+		if handler.LogTypeJavascriptHandlerFunc != nil {
+			e := &JavascriptLogEntry{}
+			if err := json.Unmarshal(params, &e); err != nil {
+				return err
 			}
+
+			return handler.LogTypeJavascriptHandlerFunc(e)
 		}
+	}
 
-		return nil
-	})
+	return nil
 }
 
 type Timestamp struct {
 	time.Time
 }
 
-// UnmarshalJSON decodes an int64 timestamp into a time.Time object
+// UnmarshalJSON decodes a timestamp in milliseconds since the epoch into a time.Time object
 func (p *Timestamp) UnmarshalJSON(bytes []byte) error {
 	var raw float64
 	if err := json.Unmarshal(bytes, &raw); err != nil {
 		return err
 	}
 
-	//TODO
-	sec, dec := math.Modf(raw)
-	p.Time = time.Unix(int64(sec), int64(dec*(1e9)))
+	ms, dec := math.Modf(raw)
+	p.Time = time.UnixMilli(int64(ms)).Add(time.Duration(dec * float64(time.Millisecond)))
 
 	return nil
 }
@@ -1,6 +1,67 @@
 package bidi
 
+import "encoding/json"
+
 type Navigation struct {
 	ID  string `json:"navigation"`
 	URL string `json:"url"`
 }
+
+// NavigationInfo is emitted with navigation events.
+type NavigationInfo struct {
+	Context    string    `json:"context"`
+	Navigation string    `json:"navigation"`
+	Timestamp  Timestamp `json:"timestamp"`
+	URL        string    `json:"url"`
+}
+
+// OnNavigationStarted registers a handler for browsingContext.navigationStarted.
+func (s *Session) OnNavigationStarted(handler func(event *NavigationInfo) error) {
+	s.client.CallbackEvent("browsingContext.navigationStarted", navigationCallback(handler))
+}
+
+// OnNavigationStarted registers a handler for browsingContext.navigationStarted events of this browsing context.
+func (b *BrowsingContext) OnNavigationStarted(handler func(event *NavigationInfo) error) {
+	b.on("browsingContext.navigationStarted", navigationCallback(handler))
+}
+
+// OnFragmentNavigated registers a handler for browsingContext.fragmentNavigated.
+func (s *Session) OnFragmentNavigated(handler func(event *NavigationInfo) error) {
+	s.client.CallbackEvent("browsingContext.fragmentNavigated", navigationCallback(handler))
+}
+
+// OnFragmentNavigated registers a handler for browsingContext.fragmentNavigated events of this browsing context.
+func (b *BrowsingContext) OnFragmentNavigated(handler func(event *NavigationInfo) error) {
+	b.on("browsingContext.fragmentNavigated", navigationCallback(handler))
+}
+
+// OnDOMContentLoaded registers a handler for browsingContext.domContentLoaded.
+func (s *Session) OnDOMContentLoaded(handler func(event *NavigationInfo) error) {
+	s.client.CallbackEvent("browsingContext.domContentLoaded", navigationCallback(handler))
+}
+
+// OnDOMContentLoaded registers a handler for browsingContext.domContentLoaded events of this browsing context.
+func (b *BrowsingContext) OnDOMContentLoaded(handler func(event *NavigationInfo) error) {
+	b.on("browsingContext.domContentLoaded", navigationCallback(handler))
+}
+
+// OnLoad registers a handler for browsingContext.load.
+func (s *Session) OnLoad(handler func(event *NavigationInfo) error) {
+	s.client.CallbackEvent("browsingContext.load", navigationCallback(handler))
+}
+
+// OnLoad registers a handler for browsingContext.load events of this browsing context.
+func (b *BrowsingContext) OnLoad(handler func(event *NavigationInfo) error) {
+	b.on("browsingContext.load", navigationCallback(handler))
+}
+
+func navigationCallback(handler func(event *NavigationInfo) error) EventCallback {
+	return func(params json.RawMessage) error {
+		e := &NavigationInfo{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	}
+}
@@ -0,0 +1,411 @@
+package bidi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// BytesValue is either a UTF-8 string or base64 encoded binary data.
+type BytesValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// String returns the decoded value. Binary values that cannot be decoded are returned as is.
+func (b BytesValue) String() string {
+	if b.Type == "base64" {
+		data, err := base64.StdEncoding.DecodeString(b.Value)
+		if err != nil {
+			return b.Value
+		}
+
+		return string(data)
+	}
+
+	return b.Value
+}
+
+// Bytes returns the decoded value.
+func (b BytesValue) Bytes() ([]byte, error) {
+	if b.Type == "base64" {
+		return base64.StdEncoding.DecodeString(b.Value)
+	}
+
+	return []byte(b.Value), nil
+}
+
+type Header struct {
+	Name  string     `json:"name"`
+	Value BytesValue `json:"value"`
+}
+
+// NewHeader returns a header with a string value.
+func NewHeader(name, value string) Header {
+	return Header{
+		Name:  name,
+		Value: BytesValue{Type: "string", Value: value},
+	}
+}
+
+type Cookie struct {
+	Name     string     `json:"name"`
+	Value    BytesValue `json:"value"`
+	Domain   string     `json:"domain"`
+	Path     string     `json:"path"`
+	Size     int        `json:"size"`
+	HTTPOnly bool       `json:"httpOnly"`
+	Secure   bool       `json:"secure"`
+	SameSite string     `json:"sameSite"`
+	Expiry   *int64     `json:"expiry,omitempty"`
+}
+
+// FetchTimingInfo holds the timings of a request in milliseconds relative to TimeOrigin.
+type FetchTimingInfo struct {
+	TimeOrigin    float64 `json:"timeOrigin"`
+	RequestTime   float64 `json:"requestTime"`
+	RedirectStart float64 `json:"redirectStart"`
+	RedirectEnd   float64 `json:"redirectEnd"`
+	FetchStart    float64 `json:"fetchStart"`
+	DNSStart      float64 `json:"dnsStart"`
+	DNSEnd        float64 `json:"dnsEnd"`
+	ConnectStart  float64 `json:"connectStart"`
+	ConnectEnd    float64 `json:"connectEnd"`
+	TLSStart      float64 `json:"tlsStart"`
+	RequestStart  float64 `json:"requestStart"`
+	ResponseStart float64 `json:"responseStart"`
+	ResponseEnd   float64 `json:"responseEnd"`
+}
+
+type RequestData struct {
+	ID          string          `json:"request"`
+	URL         string          `json:"url"`
+	Method      string          `json:"method"`
+	Headers     []Header        `json:"headers"`
+	Cookies     []Cookie        `json:"cookies"`
+	HeadersSize *int64          `json:"headersSize"`
+	BodySize    *int64          `json:"bodySize"`
+	Timings     FetchTimingInfo `json:"timings"`
+}
+
+type ResponseContent struct {
+	Size int64 `json:"size"`
+}
+
+type ResponseData struct {
+	URL           string          `json:"url"`
+	Protocol      string          `json:"protocol"`
+	Status        int             `json:"status"`
+	StatusText    string          `json:"statusText"`
+	FromCache     bool            `json:"fromCache"`
+	Headers       []Header        `json:"headers"`
+	MimeType      string          `json:"mimeType"`
+	BytesReceived int64           `json:"bytesReceived"`
+	HeadersSize   *int64          `json:"headersSize"`
+	BodySize      *int64          `json:"bodySize"`
+	Content       ResponseContent `json:"content"`
+}
+
+// NetworkEvent holds the parameters shared by all network events.
+type NetworkEvent struct {
+	Context       string      `json:"context"`
+	IsBlocked     bool        `json:"isBlocked"`
+	Navigation    string      `json:"navigation"`
+	RedirectCount int         `json:"redirectCount"`
+	Request       RequestData `json:"request"`
+	Timestamp     Timestamp   `json:"timestamp"`
+}
+
+type BeforeRequestSentEvent struct {
+	NetworkEvent
+}
+
+type ResponseEvent struct {
+	NetworkEvent
+	Response ResponseData `json:"response"`
+}
+
+type FetchErrorEvent struct {
+	NetworkEvent
+	ErrorText string `json:"errorText"`
+}
+
+// OnBeforeRequestSent registers a handler for network.beforeRequestSent.
+func (s *Session) OnBeforeRequestSent(handler func(event *BeforeRequestSentEvent) error) {
+	s.client.CallbackEvent("network.beforeRequestSent", func(params json.RawMessage) error {
+		e := &BeforeRequestSentEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnResponseStarted registers a handler for network.responseStarted.
+func (s *Session) OnResponseStarted(handler func(event *ResponseEvent) error) {
+	s.client.CallbackEvent("network.responseStarted", func(params json.RawMessage) error {
+		e := &ResponseEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnResponseCompleted registers a handler for network.responseCompleted.
+func (s *Session) OnResponseCompleted(handler func(event *ResponseEvent) error) {
+	s.client.CallbackEvent("network.responseCompleted", func(params json.RawMessage) error {
+		e := &ResponseEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnFetchError registers a handler for network.fetchError.
+func (s *Session) OnFetchError(handler func(event *FetchErrorEvent) error) {
+	s.client.CallbackEvent("network.fetchError", func(params json.RawMessage) error {
+		e := &FetchErrorEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnBeforeRequestSent registers a handler for network.beforeRequestSent events of this browsing context.
+func (b *BrowsingContext) OnBeforeRequestSent(handler func(event *BeforeRequestSentEvent) error) {
+	b.on("network.beforeRequestSent", func(params json.RawMessage) error {
+		e := &BeforeRequestSentEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnResponseStarted registers a handler for network.responseStarted events of this browsing context.
+func (b *BrowsingContext) OnResponseStarted(handler func(event *ResponseEvent) error) {
+	b.on("network.responseStarted", func(params json.RawMessage) error {
+		e := &ResponseEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnResponseCompleted registers a handler for network.responseCompleted events of this browsing context.
+func (b *BrowsingContext) OnResponseCompleted(handler func(event *ResponseEvent) error) {
+	b.on("network.responseCompleted", func(params json.RawMessage) error {
+		e := &ResponseEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+// OnFetchError registers a handler for network.fetchError events of this browsing context.
+func (b *BrowsingContext) OnFetchError(handler func(event *FetchErrorEvent) error) {
+	b.on("network.fetchError", func(params json.RawMessage) error {
+		e := &FetchErrorEvent{}
+		if err := json.Unmarshal(params, e); err != nil {
+			return err
+		}
+
+		return handler(e)
+	})
+}
+
+type NetworkScopeOptions struct {
+	// Browsing contexts the setting applies to
+	Contexts []string
+
+	// User contexts the setting applies to
+	UserContexts []string
+}
+
+// SetExtraHeaders adds headers to every request. If no contexts or user contexts are given, the
+// headers are sent by all of them. An empty list of headers removes previously set headers.
+func (s *Session) SetExtraHeaders(headers []Header, optFns ...func(o *NetworkScopeOptions)) error {
+	opts := NetworkScopeOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"headers": headers,
+	}
+
+	if len(opts.Contexts) > 0 {
+		params["contexts"] = opts.Contexts
+	}
+
+	if len(opts.UserContexts) > 0 {
+		params["userContexts"] = opts.UserContexts
+	}
+
+	_, err := s.client.Call(context.Background(), "network.setExtraHeaders", params)
+
+	return err
+}
+
+type CacheBehavior string
+
+const (
+	CacheBehaviorDefault CacheBehavior = "default"
+	CacheBehaviorBypass  CacheBehavior = "bypass"
+)
+
+// SetCacheBehavior sets whether the network cache is used. If no contexts are given, the behavior
+// applies to all of them.
+func (s *Session) SetCacheBehavior(behavior CacheBehavior, contexts ...string) error {
+	params := map[string]interface{}{
+		"cacheBehavior": behavior,
+	}
+
+	if len(contexts) > 0 {
+		params["contexts"] = contexts
+	}
+
+	_, err := s.client.Call(context.Background(), "network.setCacheBehavior", params)
+
+	return err
+}
+
+type DataType string
+
+const (
+	DataTypeResponse DataType = "response"
+)
+
+// DataCollector collects network data, such as response bodies, so that it can be retrieved
+// with GetData.
+type DataCollector struct {
+	ID     string  `json:"collector"`
+	client *Client `json:"-"`
+}
+
+type DataCollectorOptions struct {
+	// Types of data to collect. Defaults to response bodies.
+	DataTypes []DataType
+
+	// Browsing contexts to collect data from
+	Contexts []string
+
+	// User contexts to collect data from
+	UserContexts []string
+}
+
+// AddDataCollector starts collecting network data of up to maxEncodedDataSize bytes per request.
+func (s *Session) AddDataCollector(maxEncodedDataSize int, optFns ...func(o *DataCollectorOptions)) (*DataCollector, error) {
+	opts := DataCollectorOptions{
+		DataTypes: []DataType{DataTypeResponse},
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"dataTypes":          opts.DataTypes,
+		"maxEncodedDataSize": maxEncodedDataSize,
+	}
+
+	if len(opts.Contexts) > 0 {
+		params["contexts"] = opts.Contexts
+	}
+
+	if len(opts.UserContexts) > 0 {
+		params["userContexts"] = opts.UserContexts
+	}
+
+	data, err := s.client.Call(context.Background(), "network.addDataCollector", params)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &DataCollector{}
+	if err := json.Unmarshal(data, collector); err != nil {
+		return nil, err
+	}
+
+	collector.client = s.client
+
+	return collector, nil
+}
+
+// Remove stops collecting data and releases the data collected so far.
+func (c *DataCollector) Remove() error {
+	_, err := c.client.Call(context.Background(), "network.removeDataCollector", map[string]interface{}{
+		"collector": c.ID,
+	})
+
+	return err
+}
+
+type GetDataOptions struct {
+	// Type of data to retrieve. Defaults to the response body.
+	DataType DataType
+
+	// Collector to retrieve the data from. Required if Disown is set.
+	Collector *DataCollector
+
+	// Disown releases the data from the collector after it has been retrieved
+	Disown bool
+}
+
+// GetData returns collected data of a request, by default its response body. It can be called
+// from event handlers, for example for network.responseCompleted.
+func (s *Session) GetData(requestID string, optFns ...func(o *GetDataOptions)) ([]byte, error) {
+	opts := GetDataOptions{
+		DataType: DataTypeResponse,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"dataType": opts.DataType,
+		"request":  requestID,
+	}
+
+	if opts.Collector != nil {
+		params["collector"] = opts.Collector.ID
+	}
+
+	if opts.Disown {
+		params["disown"] = true
+	}
+
+	data, err := s.client.Call(context.Background(), "network.getData", params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Bytes BytesValue `json:"bytes"`
+	}{}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Bytes.Bytes()
+}
+
+// GetResponseBody returns the collected response body of a request.
+func (s *Session) GetResponseBody(requestID string) ([]byte, error) {
+	return s.GetData(requestID)
+}
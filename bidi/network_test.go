@@ -0,0 +1,103 @@
+package bidi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetExtraHeaders(t *testing.T) {
+	session, remote := newTestSession(t, nil)
+
+	assert.NoError(t, session.SetExtraHeaders([]Header{NewHeader("X-Test", "gopher")}))
+	assert.NoError(t, session.SetExtraHeaders([]Header{}, func(o *NetworkScopeOptions) {
+		o.Contexts = []string{"tab1"}
+		o.UserContexts = []string{"user1"}
+	}))
+
+	assert.Equal(t, []string{
+		`{"headers":[{"name":"X-Test","value":{"type":"string","value":"gopher"}}]}`,
+		`{"contexts":["tab1"],"headers":[],"userContexts":["user1"]}`,
+	}, remote.params("network.setExtraHeaders"))
+}
+
+func TestSetCacheBehavior(t *testing.T) {
+	session, remote := newTestSession(t, nil)
+
+	assert.NoError(t, session.SetCacheBehavior(CacheBehaviorBypass))
+	assert.NoError(t, session.SetCacheBehavior(CacheBehaviorDefault, "tab1", "tab2"))
+
+	assert.Equal(t, []string{
+		`{"cacheBehavior":"bypass"}`,
+		`{"cacheBehavior":"default","contexts":["tab1","tab2"]}`,
+	}, remote.params("network.setCacheBehavior"))
+}
+
+func TestAddDataCollector(t *testing.T) {
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "network.addDataCollector" {
+			return map[string]string{"collector": "collector-1"}, nil
+		}
+
+		return nil, nil
+	})
+
+	collector, err := session.AddDataCollector(1024, func(o *DataCollectorOptions) {
+		o.Contexts = []string{"tab1"}
+		o.UserContexts = []string{"user1"}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "collector-1", collector.ID)
+
+	_, err = session.AddDataCollector(2048)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`{"contexts":["tab1"],"dataTypes":["response"],"maxEncodedDataSize":1024,"userContexts":["user1"]}`,
+		`{"dataTypes":["response"],"maxEncodedDataSize":2048}`,
+	}, remote.params("network.addDataCollector"))
+
+	assert.NoError(t, collector.Remove())
+	assert.Equal(t, []string{`{"collector":"collector-1"}`}, remote.params("network.removeDataCollector"))
+}
+
+func TestGetData(t *testing.T) {
+	bodies := map[string]BytesValue{
+		"text":   {Type: "string", Value: "hello"},
+		"binary": {Type: "base64", Value: "AAEC"},
+		"broken": {Type: "base64", Value: "%%%"},
+	}
+
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		p := struct {
+			Request string `json:"request"`
+		}{}
+
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"bytes": bodies[p.Request]}, nil
+	})
+
+	data, err := session.GetResponseBody("text")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello"), data)
+
+	data, err = session.GetData("binary", func(o *GetDataOptions) {
+		o.Collector = &DataCollector{ID: "collector-1"}
+		o.Disown = true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2}, data)
+
+	_, err = session.GetData("broken")
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{
+		`{"dataType":"response","request":"text"}`,
+		`{"collector":"collector-1","dataType":"response","disown":true,"request":"binary"}`,
+		`{"dataType":"response","request":"broken"}`,
+	}, remote.params("network.getData"))
+}
@@ -0,0 +1,46 @@
+package bidi
+
+import (
+	"context"
+)
+
+type PermissionState string
+
+const (
+	PermissionStateGranted PermissionState = "granted"
+	PermissionStateDenied  PermissionState = "denied"
+	PermissionStatePrompt  PermissionState = "prompt"
+)
+
+type SetPermissionOptions struct {
+	// User context the permission is scoped to. If empty, the default user context is used.
+	UserContext string
+}
+
+// SetPermission sets the state of the permission with the given name, for example "geolocation"
+// or "notifications", for the origin.
+//
+// See: https://w3c.github.io/permissions/#webdriver-bidi-command-permissions-setPermission
+func (s *Session) SetPermission(name string, state PermissionState, origin string, optFns ...func(o *SetPermissionOptions)) error {
+	opts := SetPermissionOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"descriptor": map[string]interface{}{
+			"name": name,
+		},
+		"state":  state,
+		"origin": origin,
+	}
+
+	if opts.UserContext != "" {
+		params["userContext"] = opts.UserContext
+	}
+
+	_, err := s.client.Call(context.Background(), "permissions.setPermission", params)
+
+	return err
+}
@@ -0,0 +1,21 @@
+package bidi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPermission(t *testing.T) {
+	session, remote := newTestSession(t, nil)
+
+	assert.NoError(t, session.SetPermission("geolocation", PermissionStateGranted, "https://example.com"))
+	assert.NoError(t, session.SetPermission("notifications", PermissionStateDenied, "https://example.com", func(o *SetPermissionOptions) {
+		o.UserContext = "user1"
+	}))
+
+	assert.Equal(t, []string{
+		`{"descriptor":{"name":"geolocation"},"origin":"https://example.com","state":"granted"}`,
+		`{"descriptor":{"name":"notifications"},"origin":"https://example.com","state":"denied","userContext":"user1"}`,
+	}, remote.params("permissions.setPermission"))
+}
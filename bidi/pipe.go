@@ -0,0 +1,63 @@
+package bidi
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// NewPipe returns a pair of connected in-memory transports. Messages written to one end are read
+// from the other. It can be used to run a client against a scripted remote end in tests.
+func NewPipe() (Transport, Transport) {
+	a := make(chan []byte, 16) //nolint gomnd
+	b := make(chan []byte, 16) //nolint gomnd
+
+	p := &pipe{done: make(chan struct{})}
+
+	return &pipeEnd{pipe: p, in: a, out: b}, &pipeEnd{pipe: p, in: b, out: a}
+}
+
+type pipe struct {
+	once sync.Once
+	done chan struct{}
+}
+
+type pipeEnd struct {
+	*pipe
+	in  <-chan []byte
+	out chan<- []byte
+}
+
+func (p *pipeEnd) Read(ctx context.Context) ([]byte, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.done:
+		return nil, io.ErrClosedPipe
+	case msg := <-p.in:
+		return msg, nil
+	}
+}
+
+func (p *pipeEnd) Write(ctx context.Context, msg []byte) error {
+	buf := make([]byte, len(msg))
+	copy(buf, msg)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.done:
+		return io.ErrClosedPipe
+	case p.out <- buf:
+		return nil
+	}
+}
+
+// Close closes both ends of the pipe.
+func (p *pipeEnd) Close() error {
+	p.once.Do(func() {
+		close(p.done)
+	})
+
+	return nil
+}
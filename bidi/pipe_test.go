@@ -0,0 +1,47 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeTransport(t *testing.T) {
+	local, remote := NewPipe()
+	session := NewWithTransport(local)
+
+	// Scripted remote end: answer the subscription and emit an event of the subscribed context.
+	go func() {
+		data, err := remote.Read(context.Background())
+		if err != nil {
+			return
+		}
+
+		cmd := &Command{}
+		_ = json.Unmarshal(data, cmd)
+
+		_ = remote.Write(context.Background(), []byte(fmt.Sprintf(`{"type":"success","id":%d,"result":{"subscription":"sub-1"}}`, cmd.ID)))
+		_ = remote.Write(context.Background(), []byte(`{"type":"event","method":"browsingContext.load","params":{"context":"tab1","url":"https://example.com"}}`))
+	}()
+
+	loaded := make(chan string, 1)
+
+	bc := &BrowsingContext{ID: "tab1", client: session.client}
+	bc.OnLoad(func(event *NavigationInfo) error {
+		loaded <- event.URL
+		return nil
+	})
+
+	subscription, err := bc.Subscribe([]string{"browsingContext.load"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sub-1", subscription.ID)
+	assert.Equal(t, "https://example.com", <-loaded)
+
+	assert.NoError(t, session.Close())
+
+	_, err = session.client.Call(context.Background(), "session.status", map[string]interface{}{})
+	assert.Error(t, err)
+}
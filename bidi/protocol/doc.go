@@ -0,0 +1,5 @@
+// Package protocol contains the WebDriver BiDi command parameters, results and event
+// types generated from the CDDL definitions of the specification in the spec directory.
+package protocol
+
+//go:generate go run ../../internal/bidigen -remote spec/remote.cddl -local spec/local.cddl -pkg protocol -out protocol.go
@@ -0,0 +1,2268 @@
+// Code generated by bidigen. DO NOT EDIT.
+
+package protocol
+
+import "encoding/json"
+
+// Command methods.
+const (
+	CommandSessionStatus                    = "session.status"
+	CommandSessionNew                       = "session.new"
+	CommandSessionEnd                       = "session.end"
+	CommandSessionSubscribe                 = "session.subscribe"
+	CommandSessionUnsubscribe               = "session.unsubscribe"
+	CommandBrowserClose                     = "browser.close"
+	CommandBrowserCreateUserContext         = "browser.createUserContext"
+	CommandBrowserGetClientWindows          = "browser.getClientWindows"
+	CommandBrowserGetUserContexts           = "browser.getUserContexts"
+	CommandBrowserRemoveUserContext         = "browser.removeUserContext"
+	CommandBrowserSetClientWindowState      = "browser.setClientWindowState"
+	CommandBrowserSetDownloadBehavior       = "browser.setDownloadBehavior"
+	CommandBrowsingContextActivate          = "browsingContext.activate"
+	CommandBrowsingContextCaptureScreenshot = "browsingContext.captureScreenshot"
+	CommandBrowsingContextClose             = "browsingContext.close"
+	CommandBrowsingContextCreate            = "browsingContext.create"
+	CommandBrowsingContextGetTree           = "browsingContext.getTree"
+	CommandBrowsingContextHandleUserPrompt  = "browsingContext.handleUserPrompt"
+	CommandBrowsingContextLocateNodes       = "browsingContext.locateNodes"
+	CommandBrowsingContextNavigate          = "browsingContext.navigate"
+	CommandBrowsingContextPrint             = "browsingContext.print"
+	CommandBrowsingContextReload            = "browsingContext.reload"
+	CommandBrowsingContextSetViewport       = "browsingContext.setViewport"
+	CommandBrowsingContextTraverseHistory   = "browsingContext.traverseHistory"
+	CommandEmulationSetGeolocationOverride  = "emulation.setGeolocationOverride"
+	CommandEmulationSetLocaleOverride       = "emulation.setLocaleOverride"
+	CommandEmulationSetTimezoneOverride     = "emulation.setTimezoneOverride"
+	CommandNetworkAddDataCollector          = "network.addDataCollector"
+	CommandNetworkAddIntercept              = "network.addIntercept"
+	CommandNetworkContinueRequest           = "network.continueRequest"
+	CommandNetworkContinueResponse          = "network.continueResponse"
+	CommandNetworkContinueWithAuth          = "network.continueWithAuth"
+	CommandNetworkDisownData                = "network.disownData"
+	CommandNetworkFailRequest               = "network.failRequest"
+	CommandNetworkGetData                   = "network.getData"
+	CommandNetworkProvideResponse           = "network.provideResponse"
+	CommandNetworkRemoveDataCollector       = "network.removeDataCollector"
+	CommandNetworkRemoveIntercept           = "network.removeIntercept"
+	CommandNetworkSetCacheBehavior          = "network.setCacheBehavior"
+	CommandNetworkSetExtraHeaders           = "network.setExtraHeaders"
+	CommandPermissionsSetPermission         = "permissions.setPermission"
+	CommandScriptAddPreloadScript           = "script.addPreloadScript"
+	CommandScriptDisown                     = "script.disown"
+	CommandScriptCallFunction               = "script.callFunction"
+	CommandScriptEvaluate                   = "script.evaluate"
+	CommandScriptGetRealms                  = "script.getRealms"
+	CommandScriptRemovePreloadScript        = "script.removePreloadScript"
+	CommandStorageGetCookies                = "storage.getCookies"
+	CommandStorageSetCookie                 = "storage.setCookie"
+	CommandStorageDeleteCookies             = "storage.deleteCookies"
+	CommandInputPerformActions              = "input.performActions"
+	CommandInputReleaseActions              = "input.releaseActions"
+	CommandInputSetFiles                    = "input.setFiles"
+	CommandWebExtensionInstall              = "webExtension.install"
+	CommandWebExtensionUninstall            = "webExtension.uninstall"
+)
+
+// CommandParams maps each method to a new value of its parameters type.
+var CommandParams = map[string]func() interface{}{
+	CommandSessionStatus:                    func() interface{} { return new(EmptyParams) },
+	CommandSessionNew:                       func() interface{} { return new(SessionNewParameters) },
+	CommandSessionEnd:                       func() interface{} { return new(EmptyParams) },
+	CommandSessionSubscribe:                 func() interface{} { return new(SessionSubscriptionRequest) },
+	CommandSessionUnsubscribe:               func() interface{} { return new(SessionUnsubscribeParameters) },
+	CommandBrowserClose:                     func() interface{} { return new(EmptyParams) },
+	CommandBrowserCreateUserContext:         func() interface{} { return new(BrowserCreateUserContextParameters) },
+	CommandBrowserGetClientWindows:          func() interface{} { return new(EmptyParams) },
+	CommandBrowserGetUserContexts:           func() interface{} { return new(EmptyParams) },
+	CommandBrowserRemoveUserContext:         func() interface{} { return new(BrowserRemoveUserContextParameters) },
+	CommandBrowserSetClientWindowState:      func() interface{} { return new(BrowserSetClientWindowStateParameters) },
+	CommandBrowserSetDownloadBehavior:       func() interface{} { return new(BrowserSetDownloadBehaviorParameters) },
+	CommandBrowsingContextActivate:          func() interface{} { return new(BrowsingContextActivateParameters) },
+	CommandBrowsingContextCaptureScreenshot: func() interface{} { return new(BrowsingContextCaptureScreenshotParameters) },
+	CommandBrowsingContextClose:             func() interface{} { return new(BrowsingContextCloseParameters) },
+	CommandBrowsingContextCreate:            func() interface{} { return new(BrowsingContextCreateParameters) },
+	CommandBrowsingContextGetTree:           func() interface{} { return new(BrowsingContextGetTreeParameters) },
+	CommandBrowsingContextHandleUserPrompt:  func() interface{} { return new(BrowsingContextHandleUserPromptParameters) },
+	CommandBrowsingContextLocateNodes:       func() interface{} { return new(BrowsingContextLocateNodesParameters) },
+	CommandBrowsingContextNavigate:          func() interface{} { return new(BrowsingContextNavigateParameters) },
+	CommandBrowsingContextPrint:             func() interface{} { return new(BrowsingContextPrintParameters) },
+	CommandBrowsingContextReload:            func() interface{} { return new(BrowsingContextReloadParameters) },
+	CommandBrowsingContextSetViewport:       func() interface{} { return new(BrowsingContextSetViewportParameters) },
+	CommandBrowsingContextTraverseHistory:   func() interface{} { return new(BrowsingContextTraverseHistoryParameters) },
+	CommandEmulationSetGeolocationOverride:  func() interface{} { return new(EmulationSetGeolocationOverrideParameters) },
+	CommandEmulationSetLocaleOverride:       func() interface{} { return new(EmulationSetLocaleOverrideParameters) },
+	CommandEmulationSetTimezoneOverride:     func() interface{} { return new(EmulationSetTimezoneOverrideParameters) },
+	CommandNetworkAddDataCollector:          func() interface{} { return new(NetworkAddDataCollectorParameters) },
+	CommandNetworkAddIntercept:              func() interface{} { return new(NetworkAddInterceptParameters) },
+	CommandNetworkContinueRequest:           func() interface{} { return new(NetworkContinueRequestParameters) },
+	CommandNetworkContinueResponse:          func() interface{} { return new(NetworkContinueResponseParameters) },
+	CommandNetworkContinueWithAuth:          func() interface{} { return new(NetworkContinueWithAuthParameters) },
+	CommandNetworkDisownData:                func() interface{} { return new(NetworkDisownDataParameters) },
+	CommandNetworkFailRequest:               func() interface{} { return new(NetworkFailRequestParameters) },
+	CommandNetworkGetData:                   func() interface{} { return new(NetworkGetDataParameters) },
+	CommandNetworkProvideResponse:           func() interface{} { return new(NetworkProvideResponseParameters) },
+	CommandNetworkRemoveDataCollector:       func() interface{} { return new(NetworkRemoveDataCollectorParameters) },
+	CommandNetworkRemoveIntercept:           func() interface{} { return new(NetworkRemoveInterceptParameters) },
+	CommandNetworkSetCacheBehavior:          func() interface{} { return new(NetworkSetCacheBehaviorParameters) },
+	CommandNetworkSetExtraHeaders:           func() interface{} { return new(NetworkSetExtraHeadersParameters) },
+	CommandPermissionsSetPermission:         func() interface{} { return new(PermissionsSetPermissionParameters) },
+	CommandScriptAddPreloadScript:           func() interface{} { return new(ScriptAddPreloadScriptParameters) },
+	CommandScriptDisown:                     func() interface{} { return new(ScriptDisownParameters) },
+	CommandScriptCallFunction:               func() interface{} { return new(ScriptCallFunctionParameters) },
+	CommandScriptEvaluate:                   func() interface{} { return new(ScriptEvaluateParameters) },
+	CommandScriptGetRealms:                  func() interface{} { return new(ScriptGetRealmsParameters) },
+	CommandScriptRemovePreloadScript:        func() interface{} { return new(ScriptRemovePreloadScriptParameters) },
+	CommandStorageGetCookies:                func() interface{} { return new(StorageGetCookiesParameters) },
+	CommandStorageSetCookie:                 func() interface{} { return new(StorageSetCookieParameters) },
+	CommandStorageDeleteCookies:             func() interface{} { return new(StorageDeleteCookiesParameters) },
+	CommandInputPerformActions:              func() interface{} { return new(InputPerformActionsParameters) },
+	CommandInputReleaseActions:              func() interface{} { return new(InputReleaseActionsParameters) },
+	CommandInputSetFiles:                    func() interface{} { return new(InputSetFilesParameters) },
+	CommandWebExtensionInstall:              func() interface{} { return new(WebExtensionInstallParameters) },
+	CommandWebExtensionUninstall:            func() interface{} { return new(WebExtensionUninstallParameters) },
+}
+
+// Event methods.
+const (
+	EventBrowsingContextContextCreated      = "browsingContext.contextCreated"
+	EventBrowsingContextContextDestroyed    = "browsingContext.contextDestroyed"
+	EventBrowsingContextNavigationStarted   = "browsingContext.navigationStarted"
+	EventBrowsingContextFragmentNavigated   = "browsingContext.fragmentNavigated"
+	EventBrowsingContextHistoryUpdated      = "browsingContext.historyUpdated"
+	EventBrowsingContextDOMContentLoaded    = "browsingContext.domContentLoaded"
+	EventBrowsingContextLoad                = "browsingContext.load"
+	EventBrowsingContextDownloadWillBegin   = "browsingContext.downloadWillBegin"
+	EventBrowsingContextDownloadEnd         = "browsingContext.downloadEnd"
+	EventBrowsingContextNavigationAborted   = "browsingContext.navigationAborted"
+	EventBrowsingContextNavigationCommitted = "browsingContext.navigationCommitted"
+	EventBrowsingContextNavigationFailed    = "browsingContext.navigationFailed"
+	EventBrowsingContextUserPromptClosed    = "browsingContext.userPromptClosed"
+	EventBrowsingContextUserPromptOpened    = "browsingContext.userPromptOpened"
+	EventNetworkAuthRequired                = "network.authRequired"
+	EventNetworkBeforeRequestSent           = "network.beforeRequestSent"
+	EventNetworkFetchError                  = "network.fetchError"
+	EventNetworkResponseCompleted           = "network.responseCompleted"
+	EventNetworkResponseStarted             = "network.responseStarted"
+	EventScriptMessage                      = "script.message"
+	EventScriptRealmCreated                 = "script.realmCreated"
+	EventScriptRealmDestroyed               = "script.realmDestroyed"
+	EventLogEntryAdded                      = "log.entryAdded"
+)
+
+// EventParams maps each method to a new value of its parameters type.
+var EventParams = map[string]func() interface{}{
+	EventBrowsingContextContextCreated:      func() interface{} { return new(BrowsingContextInfo) },
+	EventBrowsingContextContextDestroyed:    func() interface{} { return new(BrowsingContextInfo) },
+	EventBrowsingContextNavigationStarted:   func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextFragmentNavigated:   func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextHistoryUpdated:      func() interface{} { return new(BrowsingContextHistoryUpdatedParameters) },
+	EventBrowsingContextDOMContentLoaded:    func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextLoad:                func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextDownloadWillBegin:   func() interface{} { return new(BrowsingContextDownloadWillBeginParams) },
+	EventBrowsingContextDownloadEnd:         func() interface{} { return new(BrowsingContextDownloadEndParams) },
+	EventBrowsingContextNavigationAborted:   func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextNavigationCommitted: func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextNavigationFailed:    func() interface{} { return new(BrowsingContextNavigationInfo) },
+	EventBrowsingContextUserPromptClosed:    func() interface{} { return new(BrowsingContextUserPromptClosedParameters) },
+	EventBrowsingContextUserPromptOpened:    func() interface{} { return new(BrowsingContextUserPromptOpenedParameters) },
+	EventNetworkAuthRequired:                func() interface{} { return new(NetworkAuthRequiredParameters) },
+	EventNetworkBeforeRequestSent:           func() interface{} { return new(NetworkBeforeRequestSentParameters) },
+	EventNetworkFetchError:                  func() interface{} { return new(NetworkFetchErrorParameters) },
+	EventNetworkResponseCompleted:           func() interface{} { return new(NetworkResponseCompletedParameters) },
+	EventNetworkResponseStarted:             func() interface{} { return new(NetworkResponseStartedParameters) },
+	EventScriptMessage:                      func() interface{} { return new(ScriptMessageParameters) },
+	EventScriptRealmCreated:                 func() interface{} { return new(ScriptRealmInfo) },
+	EventScriptRealmDestroyed:               func() interface{} { return new(ScriptRealmDestroyedParameters) },
+	EventLogEntryAdded:                      func() interface{} { return new(LogEntry) },
+}
+
+// Command is generated from Command.
+type Command struct {
+	ID     int64           `json:"id"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// EmptyParams is generated from EmptyParams.
+type EmptyParams struct {
+}
+
+// SessionCapabilitiesRequest is generated from session.CapabilitiesRequest.
+type SessionCapabilitiesRequest struct {
+	AlwaysMatch *SessionCapabilityRequest  `json:"alwaysMatch,omitempty"`
+	FirstMatch  []SessionCapabilityRequest `json:"firstMatch,omitempty"`
+}
+
+// SessionCapabilityRequest is generated from session.CapabilityRequest.
+type SessionCapabilityRequest struct {
+	AcceptInsecureCerts     *bool                      `json:"acceptInsecureCerts,omitempty"`
+	BrowserName             string                     `json:"browserName,omitempty"`
+	BrowserVersion          string                     `json:"browserVersion,omitempty"`
+	PlatformName            string                     `json:"platformName,omitempty"`
+	Proxy                   *SessionProxyConfiguration `json:"proxy,omitempty"`
+	UnhandledPromptBehavior *SessionUserPromptHandler  `json:"unhandledPromptBehavior,omitempty"`
+}
+
+// SessionProxyConfiguration is generated from session.ProxyConfiguration.
+type SessionProxyConfiguration struct {
+	ProxyType          string   `json:"proxyType"`
+	HTTPProxy          string   `json:"httpProxy,omitempty"`
+	SSLProxy           string   `json:"sslProxy,omitempty"`
+	SocksProxy         string   `json:"socksProxy,omitempty"`
+	SocksVersion       *int64   `json:"socksVersion,omitempty"`
+	NoProxy            []string `json:"noProxy,omitempty"`
+	ProxyAutoconfigURL string   `json:"proxyAutoconfigUrl,omitempty"`
+}
+
+// SessionAutodetectProxyConfiguration is generated from session.AutodetectProxyConfiguration.
+type SessionAutodetectProxyConfiguration struct {
+	ProxyType string `json:"proxyType"`
+}
+
+// SessionDirectProxyConfiguration is generated from session.DirectProxyConfiguration.
+type SessionDirectProxyConfiguration struct {
+	ProxyType string `json:"proxyType"`
+}
+
+// SessionManualProxyConfiguration is generated from session.ManualProxyConfiguration.
+type SessionManualProxyConfiguration struct {
+	ProxyType    string   `json:"proxyType"`
+	HTTPProxy    string   `json:"httpProxy,omitempty"`
+	SSLProxy     string   `json:"sslProxy,omitempty"`
+	SocksProxy   string   `json:"socksProxy,omitempty"`
+	SocksVersion *int64   `json:"socksVersion,omitempty"`
+	NoProxy      []string `json:"noProxy,omitempty"`
+}
+
+// SessionSocksProxyConfiguration is generated from session.SocksProxyConfiguration.
+type SessionSocksProxyConfiguration struct {
+	SocksProxy   string `json:"socksProxy"`
+	SocksVersion int64  `json:"socksVersion"`
+}
+
+// SessionPacProxyConfiguration is generated from session.PacProxyConfiguration.
+type SessionPacProxyConfiguration struct {
+	ProxyType          string `json:"proxyType"`
+	ProxyAutoconfigURL string `json:"proxyAutoconfigUrl"`
+}
+
+// SessionSystemProxyConfiguration is generated from session.SystemProxyConfiguration.
+type SessionSystemProxyConfiguration struct {
+	ProxyType string `json:"proxyType"`
+}
+
+// SessionUserPromptHandler is generated from session.UserPromptHandler.
+type SessionUserPromptHandler struct {
+	Alert        SessionUserPromptHandlerType `json:"alert,omitempty"`
+	BeforeUnload SessionUserPromptHandlerType `json:"beforeUnload,omitempty"`
+	Confirm      SessionUserPromptHandlerType `json:"confirm,omitempty"`
+	Default      SessionUserPromptHandlerType `json:"default,omitempty"`
+	File         SessionUserPromptHandlerType `json:"file,omitempty"`
+	Prompt       SessionUserPromptHandlerType `json:"prompt,omitempty"`
+}
+
+// SessionUserPromptHandlerType is generated from session.UserPromptHandlerType.
+type SessionUserPromptHandlerType string
+
+const (
+	SessionUserPromptHandlerTypeAccept  SessionUserPromptHandlerType = "accept"
+	SessionUserPromptHandlerTypeDismiss SessionUserPromptHandlerType = "dismiss"
+	SessionUserPromptHandlerTypeIgnore  SessionUserPromptHandlerType = "ignore"
+)
+
+// SessionSubscription is generated from session.Subscription.
+type SessionSubscription string
+
+// SessionSubscriptionRequest is generated from session.SubscriptionRequest.
+type SessionSubscriptionRequest struct {
+	Events       []string                         `json:"events"`
+	Contexts     []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// SessionUnsubscribeByIDRequest is generated from session.UnsubscribeByIDRequest.
+type SessionUnsubscribeByIDRequest struct {
+	Subscriptions []SessionSubscription `json:"subscriptions"`
+}
+
+// SessionUnsubscribeByAttributesRequest is generated from session.UnsubscribeByAttributesRequest.
+type SessionUnsubscribeByAttributesRequest struct {
+	Events   []string                         `json:"events"`
+	Contexts []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+}
+
+// SessionNewParameters is generated from session.NewParameters.
+type SessionNewParameters struct {
+	Capabilities SessionCapabilitiesRequest `json:"capabilities"`
+}
+
+// SessionUnsubscribeParameters is generated from session.UnsubscribeParameters.
+type SessionUnsubscribeParameters struct {
+	Events        []string                         `json:"events,omitempty"`
+	Contexts      []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	Subscriptions []SessionSubscription            `json:"subscriptions,omitempty"`
+}
+
+// BrowserClientWindow is generated from browser.ClientWindow.
+type BrowserClientWindow string
+
+// BrowserClientWindowInfo is generated from browser.ClientWindowInfo.
+type BrowserClientWindowInfo struct {
+	Active       bool                `json:"active"`
+	ClientWindow BrowserClientWindow `json:"clientWindow"`
+	Height       int64               `json:"height"`
+	State        string              `json:"state"`
+	Width        int64               `json:"width"`
+	X            int64               `json:"x"`
+	Y            int64               `json:"y"`
+}
+
+// BrowserUserContext is generated from browser.UserContext.
+type BrowserUserContext string
+
+// BrowserUserContextInfo is generated from browser.UserContextInfo.
+type BrowserUserContextInfo struct {
+	UserContext BrowserUserContext `json:"userContext"`
+}
+
+// BrowserCreateUserContextParameters is generated from browser.CreateUserContextParameters.
+type BrowserCreateUserContextParameters struct {
+	AcceptInsecureCerts     *bool                      `json:"acceptInsecureCerts,omitempty"`
+	Proxy                   *SessionProxyConfiguration `json:"proxy,omitempty"`
+	UnhandledPromptBehavior *SessionUserPromptHandler  `json:"unhandledPromptBehavior,omitempty"`
+}
+
+// BrowserRemoveUserContextParameters is generated from browser.RemoveUserContextParameters.
+type BrowserRemoveUserContextParameters struct {
+	UserContext BrowserUserContext `json:"userContext"`
+}
+
+// BrowserSetClientWindowStateParameters is generated from browser.SetClientWindowStateParameters.
+type BrowserSetClientWindowStateParameters struct {
+	ClientWindow BrowserClientWindow `json:"clientWindow"`
+	State        string              `json:"state"`
+	Width        *int64              `json:"width,omitempty"`
+	Height       *int64              `json:"height,omitempty"`
+	X            *int64              `json:"x,omitempty"`
+	Y            *int64              `json:"y,omitempty"`
+}
+
+// BrowserClientWindowNamedState is generated from browser.ClientWindowNamedState.
+type BrowserClientWindowNamedState struct {
+	State string `json:"state"`
+}
+
+// BrowserClientWindowRectState is generated from browser.ClientWindowRectState.
+type BrowserClientWindowRectState struct {
+	State  string `json:"state"`
+	Width  *int64 `json:"width,omitempty"`
+	Height *int64 `json:"height,omitempty"`
+	X      *int64 `json:"x,omitempty"`
+	Y      *int64 `json:"y,omitempty"`
+}
+
+// BrowserSetDownloadBehaviorParameters is generated from browser.SetDownloadBehaviorParameters.
+type BrowserSetDownloadBehaviorParameters struct {
+	DownloadBehavior *BrowserDownloadBehavior `json:"downloadBehavior"`
+	UserContexts     []BrowserUserContext     `json:"userContexts,omitempty"`
+}
+
+// BrowserDownloadBehavior is generated from browser.DownloadBehavior.
+type BrowserDownloadBehavior struct {
+	Type              string `json:"type"`
+	DestinationFolder string `json:"destinationFolder,omitempty"`
+}
+
+// BrowserDownloadBehaviorAllowed is generated from browser.DownloadBehaviorAllowed.
+type BrowserDownloadBehaviorAllowed struct {
+	Type              string `json:"type"`
+	DestinationFolder string `json:"destinationFolder"`
+}
+
+// BrowserDownloadBehaviorDenied is generated from browser.DownloadBehaviorDenied.
+type BrowserDownloadBehaviorDenied struct {
+	Type string `json:"type"`
+}
+
+// BrowsingContextBrowsingContext is generated from browsingContext.BrowsingContext.
+type BrowsingContextBrowsingContext string
+
+// BrowsingContextLocator is generated from browsingContext.Locator.
+type BrowsingContextLocator struct {
+	Type       string          `json:"type"`
+	Value      json.RawMessage `json:"value"`
+	IgnoreCase *bool           `json:"ignoreCase,omitempty"`
+	MatchType  string          `json:"matchType,omitempty"`
+	MaxDepth   *int64          `json:"maxDepth,omitempty"`
+}
+
+// BrowsingContextAccessibilityLocator is generated from browsingContext.AccessibilityLocator.
+type BrowsingContextAccessibilityLocator struct {
+	Type  string                                   `json:"type"`
+	Value BrowsingContextAccessibilityLocatorValue `json:"value"`
+}
+
+// BrowsingContextCSSLocator is generated from browsingContext.CssLocator.
+type BrowsingContextCSSLocator struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// BrowsingContextContextLocator is generated from browsingContext.ContextLocator.
+type BrowsingContextContextLocator struct {
+	Type  string                             `json:"type"`
+	Value BrowsingContextContextLocatorValue `json:"value"`
+}
+
+// BrowsingContextInnerTextLocator is generated from browsingContext.InnerTextLocator.
+type BrowsingContextInnerTextLocator struct {
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	IgnoreCase *bool  `json:"ignoreCase,omitempty"`
+	MatchType  string `json:"matchType,omitempty"`
+	MaxDepth   *int64 `json:"maxDepth,omitempty"`
+}
+
+// BrowsingContextXPathLocator is generated from browsingContext.XPathLocator.
+type BrowsingContextXPathLocator struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// BrowsingContextNavigation is generated from browsingContext.Navigation.
+type BrowsingContextNavigation string
+
+// BrowsingContextReadinessState is generated from browsingContext.ReadinessState.
+type BrowsingContextReadinessState string
+
+const (
+	BrowsingContextReadinessStateNone        BrowsingContextReadinessState = "none"
+	BrowsingContextReadinessStateInteractive BrowsingContextReadinessState = "interactive"
+	BrowsingContextReadinessStateComplete    BrowsingContextReadinessState = "complete"
+)
+
+// BrowsingContextUserPromptType is generated from browsingContext.UserPromptType.
+type BrowsingContextUserPromptType string
+
+const (
+	BrowsingContextUserPromptTypeAlert        BrowsingContextUserPromptType = "alert"
+	BrowsingContextUserPromptTypeBeforeunload BrowsingContextUserPromptType = "beforeunload"
+	BrowsingContextUserPromptTypeConfirm      BrowsingContextUserPromptType = "confirm"
+	BrowsingContextUserPromptTypePrompt       BrowsingContextUserPromptType = "prompt"
+)
+
+// BrowsingContextActivateParameters is generated from browsingContext.ActivateParameters.
+type BrowsingContextActivateParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// BrowsingContextCaptureScreenshotParameters is generated from browsingContext.CaptureScreenshotParameters.
+type BrowsingContextCaptureScreenshotParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Origin  string                         `json:"origin,omitempty"`
+	Format  *BrowsingContextImageFormat    `json:"format,omitempty"`
+	Clip    *BrowsingContextClipRectangle  `json:"clip,omitempty"`
+}
+
+// BrowsingContextImageFormat is generated from browsingContext.ImageFormat.
+type BrowsingContextImageFormat struct {
+	Type    string   `json:"type"`
+	Quality *float64 `json:"quality,omitempty"`
+}
+
+// BrowsingContextClipRectangle is generated from browsingContext.ClipRectangle.
+type BrowsingContextClipRectangle struct {
+	Type    string                 `json:"type"`
+	X       *float64               `json:"x,omitempty"`
+	Y       *float64               `json:"y,omitempty"`
+	Width   *float64               `json:"width,omitempty"`
+	Height  *float64               `json:"height,omitempty"`
+	Element *ScriptSharedReference `json:"element,omitempty"`
+}
+
+// BrowsingContextElementClipRectangle is generated from browsingContext.ElementClipRectangle.
+type BrowsingContextElementClipRectangle struct {
+	Type    string                `json:"type"`
+	Element ScriptSharedReference `json:"element"`
+}
+
+// BrowsingContextBoxClipRectangle is generated from browsingContext.BoxClipRectangle.
+type BrowsingContextBoxClipRectangle struct {
+	Type   string  `json:"type"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// BrowsingContextCloseParameters is generated from browsingContext.CloseParameters.
+type BrowsingContextCloseParameters struct {
+	Context      BrowsingContextBrowsingContext `json:"context"`
+	PromptUnload *bool                          `json:"promptUnload,omitempty"`
+}
+
+// BrowsingContextCreateType is generated from browsingContext.CreateType.
+type BrowsingContextCreateType string
+
+const (
+	BrowsingContextCreateTypeTab    BrowsingContextCreateType = "tab"
+	BrowsingContextCreateTypeWindow BrowsingContextCreateType = "window"
+)
+
+// BrowsingContextCreateParameters is generated from browsingContext.CreateParameters.
+type BrowsingContextCreateParameters struct {
+	Type             BrowsingContextCreateType      `json:"type"`
+	ReferenceContext BrowsingContextBrowsingContext `json:"referenceContext,omitempty"`
+	Background       *bool                          `json:"background,omitempty"`
+	UserContext      BrowserUserContext             `json:"userContext,omitempty"`
+}
+
+// BrowsingContextGetTreeParameters is generated from browsingContext.GetTreeParameters.
+type BrowsingContextGetTreeParameters struct {
+	MaxDepth *int64                         `json:"maxDepth,omitempty"`
+	Root     BrowsingContextBrowsingContext `json:"root,omitempty"`
+}
+
+// BrowsingContextHandleUserPromptParameters is generated from browsingContext.HandleUserPromptParameters.
+type BrowsingContextHandleUserPromptParameters struct {
+	Context  BrowsingContextBrowsingContext `json:"context"`
+	Accept   *bool                          `json:"accept,omitempty"`
+	UserText string                         `json:"userText,omitempty"`
+}
+
+// BrowsingContextLocateNodesParameters is generated from browsingContext.LocateNodesParameters.
+type BrowsingContextLocateNodesParameters struct {
+	Context              BrowsingContextBrowsingContext `json:"context"`
+	Locator              BrowsingContextLocator         `json:"locator"`
+	MaxNodeCount         *int64                         `json:"maxNodeCount,omitempty"`
+	SerializationOptions *ScriptSerializationOptions    `json:"serializationOptions,omitempty"`
+	StartNodes           []ScriptSharedReference        `json:"startNodes,omitempty"`
+}
+
+// BrowsingContextNavigateParameters is generated from browsingContext.NavigateParameters.
+type BrowsingContextNavigateParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	URL     string                         `json:"url"`
+	Wait    BrowsingContextReadinessState  `json:"wait,omitempty"`
+}
+
+// BrowsingContextPrintParameters is generated from browsingContext.PrintParameters.
+type BrowsingContextPrintParameters struct {
+	Context     BrowsingContextBrowsingContext        `json:"context"`
+	Background  *bool                                 `json:"background,omitempty"`
+	Margin      *BrowsingContextPrintMarginParameters `json:"margin,omitempty"`
+	Orientation string                                `json:"orientation,omitempty"`
+	Page        *BrowsingContextPrintPageParameters   `json:"page,omitempty"`
+	PageRanges  []json.RawMessage                     `json:"pageRanges,omitempty"`
+	Scale       *float64                              `json:"scale,omitempty"`
+	ShrinkToFit *bool                                 `json:"shrinkToFit,omitempty"`
+}
+
+// BrowsingContextPrintMarginParameters is generated from browsingContext.PrintMarginParameters.
+type BrowsingContextPrintMarginParameters struct {
+	Bottom *float64 `json:"bottom,omitempty"`
+	Left   *float64 `json:"left,omitempty"`
+	Right  *float64 `json:"right,omitempty"`
+	Top    *float64 `json:"top,omitempty"`
+}
+
+// BrowsingContextPrintPageParameters is generated from browsingContext.PrintPageParameters.
+type BrowsingContextPrintPageParameters struct {
+	Height *float64 `json:"height,omitempty"`
+	Width  *float64 `json:"width,omitempty"`
+}
+
+// BrowsingContextReloadParameters is generated from browsingContext.ReloadParameters.
+type BrowsingContextReloadParameters struct {
+	Context     BrowsingContextBrowsingContext `json:"context"`
+	IgnoreCache *bool                          `json:"ignoreCache,omitempty"`
+	Wait        BrowsingContextReadinessState  `json:"wait,omitempty"`
+}
+
+// BrowsingContextSetViewportParameters is generated from browsingContext.SetViewportParameters.
+type BrowsingContextSetViewportParameters struct {
+	Context          BrowsingContextBrowsingContext `json:"context,omitempty"`
+	Viewport         *BrowsingContextViewport       `json:"viewport,omitempty"`
+	DevicePixelRatio *float64                       `json:"devicePixelRatio,omitempty"`
+	UserContexts     []BrowserUserContext           `json:"userContexts,omitempty"`
+}
+
+// BrowsingContextViewport is generated from browsingContext.Viewport.
+type BrowsingContextViewport struct {
+	Width  int64 `json:"width"`
+	Height int64 `json:"height"`
+}
+
+// BrowsingContextTraverseHistoryParameters is generated from browsingContext.TraverseHistoryParameters.
+type BrowsingContextTraverseHistoryParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Delta   int64                          `json:"delta"`
+}
+
+// EmulationSetGeolocationOverrideParameters is generated from emulation.SetGeolocationOverrideParameters.
+type EmulationSetGeolocationOverrideParameters struct {
+	Coordinates  *EmulationGeolocationCoordinates `json:"coordinates"`
+	Contexts     []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// EmulationGeolocationCoordinates is generated from emulation.GeolocationCoordinates.
+type EmulationGeolocationCoordinates struct {
+	Latitude         float64  `json:"latitude"`
+	Longitude        float64  `json:"longitude"`
+	Accuracy         *float64 `json:"accuracy,omitempty"`
+	Altitude         *float64 `json:"altitude,omitempty"`
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"`
+	Heading          *float64 `json:"heading,omitempty"`
+	Speed            *float64 `json:"speed,omitempty"`
+}
+
+// EmulationSetLocaleOverrideParameters is generated from emulation.SetLocaleOverrideParameters.
+type EmulationSetLocaleOverrideParameters struct {
+	Locale       *string                          `json:"locale"`
+	Contexts     []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// EmulationSetTimezoneOverrideParameters is generated from emulation.SetTimezoneOverrideParameters.
+type EmulationSetTimezoneOverrideParameters struct {
+	Timezone     *string                          `json:"timezone"`
+	Contexts     []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// NetworkAuthCredentials is generated from network.AuthCredentials.
+type NetworkAuthCredentials struct {
+	Type     string `json:"type"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// NetworkBytesValue is generated from network.BytesValue.
+type NetworkBytesValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NetworkStringValue is generated from network.StringValue.
+type NetworkStringValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NetworkBase64Value is generated from network.Base64Value.
+type NetworkBase64Value struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NetworkCollector is generated from network.Collector.
+type NetworkCollector string
+
+// NetworkCollectorType is generated from network.CollectorType.
+type NetworkCollectorType string
+
+const (
+	NetworkCollectorTypeBlob NetworkCollectorType = "blob"
+)
+
+// NetworkCookieHeader is generated from network.CookieHeader.
+type NetworkCookieHeader struct {
+	Name  string            `json:"name"`
+	Value NetworkBytesValue `json:"value"`
+}
+
+// NetworkDataType is generated from network.DataType.
+type NetworkDataType string
+
+const (
+	NetworkDataTypeResponse NetworkDataType = "response"
+)
+
+// NetworkHeader is generated from network.Header.
+type NetworkHeader struct {
+	Name  string            `json:"name"`
+	Value NetworkBytesValue `json:"value"`
+}
+
+// NetworkIntercept is generated from network.Intercept.
+type NetworkIntercept string
+
+// NetworkRequest is generated from network.Request.
+type NetworkRequest string
+
+// NetworkSameSite is generated from network.SameSite.
+type NetworkSameSite string
+
+const (
+	NetworkSameSiteStrict  NetworkSameSite = "strict"
+	NetworkSameSiteLax     NetworkSameSite = "lax"
+	NetworkSameSiteNone    NetworkSameSite = "none"
+	NetworkSameSiteDefault NetworkSameSite = "default"
+)
+
+// NetworkSetCookieHeader is generated from network.SetCookieHeader.
+type NetworkSetCookieHeader struct {
+	Name     string            `json:"name"`
+	Value    NetworkBytesValue `json:"value"`
+	Domain   string            `json:"domain,omitempty"`
+	HTTPOnly *bool             `json:"httpOnly,omitempty"`
+	Expiry   string            `json:"expiry,omitempty"`
+	MaxAge   *int64            `json:"maxAge,omitempty"`
+	Path     string            `json:"path,omitempty"`
+	SameSite NetworkSameSite   `json:"sameSite,omitempty"`
+	Secure   *bool             `json:"secure,omitempty"`
+}
+
+// NetworkURLPattern is generated from network.UrlPattern.
+type NetworkURLPattern struct {
+	Type     string `json:"type"`
+	Protocol string `json:"protocol,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     string `json:"port,omitempty"`
+	Pathname string `json:"pathname,omitempty"`
+	Search   string `json:"search,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+}
+
+// NetworkURLPatternPattern is generated from network.UrlPatternPattern.
+type NetworkURLPatternPattern struct {
+	Type     string `json:"type"`
+	Protocol string `json:"protocol,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     string `json:"port,omitempty"`
+	Pathname string `json:"pathname,omitempty"`
+	Search   string `json:"search,omitempty"`
+}
+
+// NetworkURLPatternString is generated from network.UrlPatternString.
+type NetworkURLPatternString struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+// NetworkInterceptPhase is generated from network.InterceptPhase.
+type NetworkInterceptPhase string
+
+const (
+	NetworkInterceptPhaseBeforeRequestSent NetworkInterceptPhase = "beforeRequestSent"
+	NetworkInterceptPhaseResponseStarted   NetworkInterceptPhase = "responseStarted"
+	NetworkInterceptPhaseAuthRequired      NetworkInterceptPhase = "authRequired"
+)
+
+// NetworkAddDataCollectorParameters is generated from network.AddDataCollectorParameters.
+type NetworkAddDataCollectorParameters struct {
+	DataTypes          []NetworkDataType                `json:"dataTypes"`
+	MaxEncodedDataSize int64                            `json:"maxEncodedDataSize"`
+	CollectorType      NetworkCollectorType             `json:"collectorType,omitempty"`
+	Contexts           []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts       []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// NetworkAddInterceptParameters is generated from network.AddInterceptParameters.
+type NetworkAddInterceptParameters struct {
+	Phases      []NetworkInterceptPhase          `json:"phases"`
+	Contexts    []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	URLPatterns []NetworkURLPattern              `json:"urlPatterns,omitempty"`
+}
+
+// NetworkContinueRequestParameters is generated from network.ContinueRequestParameters.
+type NetworkContinueRequestParameters struct {
+	Request NetworkRequest        `json:"request"`
+	Body    *NetworkBytesValue    `json:"body,omitempty"`
+	Cookies []NetworkCookieHeader `json:"cookies,omitempty"`
+	Headers []NetworkHeader       `json:"headers,omitempty"`
+	Method  string                `json:"method,omitempty"`
+	URL     string                `json:"url,omitempty"`
+}
+
+// NetworkContinueResponseParameters is generated from network.ContinueResponseParameters.
+type NetworkContinueResponseParameters struct {
+	Request      NetworkRequest           `json:"request"`
+	Cookies      []NetworkSetCookieHeader `json:"cookies,omitempty"`
+	Credentials  *NetworkAuthCredentials  `json:"credentials,omitempty"`
+	Headers      []NetworkHeader          `json:"headers,omitempty"`
+	ReasonPhrase string                   `json:"reasonPhrase,omitempty"`
+	StatusCode   *int64                   `json:"statusCode,omitempty"`
+}
+
+// NetworkContinueWithAuthParameters is generated from network.ContinueWithAuthParameters.
+type NetworkContinueWithAuthParameters struct {
+	Request     NetworkRequest          `json:"request"`
+	Action      string                  `json:"action"`
+	Credentials *NetworkAuthCredentials `json:"credentials,omitempty"`
+}
+
+// NetworkContinueWithAuthCredentials is generated from network.ContinueWithAuthCredentials.
+type NetworkContinueWithAuthCredentials struct {
+	Action      string                 `json:"action"`
+	Credentials NetworkAuthCredentials `json:"credentials"`
+}
+
+// NetworkContinueWithAuthNoCredentials is generated from network.ContinueWithAuthNoCredentials.
+type NetworkContinueWithAuthNoCredentials struct {
+	Action string `json:"action"`
+}
+
+// NetworkDisownDataParameters is generated from network.DisownDataParameters.
+type NetworkDisownDataParameters struct {
+	DataType  NetworkDataType  `json:"dataType"`
+	Collector NetworkCollector `json:"collector"`
+	Request   NetworkRequest   `json:"request"`
+}
+
+// NetworkFailRequestParameters is generated from network.FailRequestParameters.
+type NetworkFailRequestParameters struct {
+	Request NetworkRequest `json:"request"`
+}
+
+// NetworkGetDataParameters is generated from network.GetDataParameters.
+type NetworkGetDataParameters struct {
+	DataType  NetworkDataType  `json:"dataType"`
+	Collector NetworkCollector `json:"collector,omitempty"`
+	Disown    *bool            `json:"disown,omitempty"`
+	Request   NetworkRequest   `json:"request"`
+}
+
+// NetworkProvideResponseParameters is generated from network.ProvideResponseParameters.
+type NetworkProvideResponseParameters struct {
+	Request      NetworkRequest           `json:"request"`
+	Body         *NetworkBytesValue       `json:"body,omitempty"`
+	Cookies      []NetworkSetCookieHeader `json:"cookies,omitempty"`
+	Headers      []NetworkHeader          `json:"headers,omitempty"`
+	ReasonPhrase string                   `json:"reasonPhrase,omitempty"`
+	StatusCode   *int64                   `json:"statusCode,omitempty"`
+}
+
+// NetworkRemoveDataCollectorParameters is generated from network.RemoveDataCollectorParameters.
+type NetworkRemoveDataCollectorParameters struct {
+	Collector NetworkCollector `json:"collector"`
+}
+
+// NetworkRemoveInterceptParameters is generated from network.RemoveInterceptParameters.
+type NetworkRemoveInterceptParameters struct {
+	Intercept NetworkIntercept `json:"intercept"`
+}
+
+// NetworkSetCacheBehaviorParameters is generated from network.SetCacheBehaviorParameters.
+type NetworkSetCacheBehaviorParameters struct {
+	CacheBehavior string                           `json:"cacheBehavior"`
+	Contexts      []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+}
+
+// NetworkSetExtraHeadersParameters is generated from network.SetExtraHeadersParameters.
+type NetworkSetExtraHeadersParameters struct {
+	Headers      []NetworkHeader                  `json:"headers"`
+	Contexts     []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts []BrowserUserContext             `json:"userContexts,omitempty"`
+}
+
+// PermissionsPermissionDescriptor is generated from permissions.PermissionDescriptor.
+type PermissionsPermissionDescriptor struct {
+	Name string `json:"name"`
+}
+
+// PermissionsPermissionState is generated from permissions.PermissionState.
+type PermissionsPermissionState string
+
+const (
+	PermissionsPermissionStateGranted PermissionsPermissionState = "granted"
+	PermissionsPermissionStateDenied  PermissionsPermissionState = "denied"
+	PermissionsPermissionStatePrompt  PermissionsPermissionState = "prompt"
+)
+
+// PermissionsSetPermissionParameters is generated from permissions.SetPermissionParameters.
+type PermissionsSetPermissionParameters struct {
+	Descriptor  PermissionsPermissionDescriptor `json:"descriptor"`
+	State       PermissionsPermissionState      `json:"state"`
+	Origin      string                          `json:"origin"`
+	UserContext string                          `json:"userContext,omitempty"`
+}
+
+// ScriptChannel is generated from script.Channel.
+type ScriptChannel string
+
+// ScriptChannelValue is generated from script.ChannelValue.
+type ScriptChannelValue struct {
+	Type  string                  `json:"type"`
+	Value ScriptChannelProperties `json:"value"`
+}
+
+// ScriptChannelProperties is generated from script.ChannelProperties.
+type ScriptChannelProperties struct {
+	Channel              ScriptChannel               `json:"channel"`
+	SerializationOptions *ScriptSerializationOptions `json:"serializationOptions,omitempty"`
+	Ownership            ScriptResultOwnership       `json:"ownership,omitempty"`
+}
+
+// ScriptContextTarget is generated from script.ContextTarget.
+type ScriptContextTarget struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Sandbox string                         `json:"sandbox,omitempty"`
+}
+
+// ScriptHandle is generated from script.Handle.
+type ScriptHandle string
+
+// ScriptInternalID is generated from script.InternalId.
+type ScriptInternalID string
+
+// ScriptLocalValue is generated from script.LocalValue.
+type ScriptLocalValue struct {
+	SharedID ScriptSharedID  `json:"sharedId,omitempty"`
+	Handle   ScriptHandle    `json:"handle,omitempty"`
+	Type     string          `json:"type,omitempty"`
+	Value    json.RawMessage `json:"value,omitempty"`
+}
+
+// ScriptListLocalValue is generated from script.ListLocalValue.
+type ScriptListLocalValue []ScriptLocalValue
+
+// ScriptArrayLocalValue is generated from script.ArrayLocalValue.
+type ScriptArrayLocalValue struct {
+	Type  string               `json:"type"`
+	Value ScriptListLocalValue `json:"value"`
+}
+
+// ScriptDateLocalValue is generated from script.DateLocalValue.
+type ScriptDateLocalValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ScriptMappingLocalValue is generated from script.MappingLocalValue.
+type ScriptMappingLocalValue [][]json.RawMessage
+
+// ScriptMapLocalValue is generated from script.MapLocalValue.
+type ScriptMapLocalValue struct {
+	Type  string                  `json:"type"`
+	Value ScriptMappingLocalValue `json:"value"`
+}
+
+// ScriptObjectLocalValue is generated from script.ObjectLocalValue.
+type ScriptObjectLocalValue struct {
+	Type  string                  `json:"type"`
+	Value ScriptMappingLocalValue `json:"value"`
+}
+
+// ScriptRegExpValue is generated from script.RegExpValue.
+type ScriptRegExpValue struct {
+	Pattern string `json:"pattern"`
+	Flags   string `json:"flags,omitempty"`
+}
+
+// ScriptRegExpLocalValue is generated from script.RegExpLocalValue.
+type ScriptRegExpLocalValue struct {
+	Type  string            `json:"type"`
+	Value ScriptRegExpValue `json:"value"`
+}
+
+// ScriptSetLocalValue is generated from script.SetLocalValue.
+type ScriptSetLocalValue struct {
+	Type  string               `json:"type"`
+	Value ScriptListLocalValue `json:"value"`
+}
+
+// ScriptPreloadScript is generated from script.PreloadScript.
+type ScriptPreloadScript string
+
+// ScriptRealm is generated from script.Realm.
+type ScriptRealm string
+
+// ScriptPrimitiveProtocolValue is generated from script.PrimitiveProtocolValue.
+type ScriptPrimitiveProtocolValue struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ScriptUndefinedValue is generated from script.UndefinedValue.
+type ScriptUndefinedValue struct {
+	Type string `json:"type"`
+}
+
+// ScriptNullValue is generated from script.NullValue.
+type ScriptNullValue struct {
+	Type string `json:"type"`
+}
+
+// ScriptStringValue is generated from script.StringValue.
+type ScriptStringValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ScriptSpecialNumber is generated from script.SpecialNumber.
+type ScriptSpecialNumber string
+
+const (
+	ScriptSpecialNumberNaN           ScriptSpecialNumber = "NaN"
+	ScriptSpecialNumberMinus0        ScriptSpecialNumber = "-0"
+	ScriptSpecialNumberInfinity      ScriptSpecialNumber = "Infinity"
+	ScriptSpecialNumberMinusInfinity ScriptSpecialNumber = "-Infinity"
+)
+
+// ScriptNumberValue is generated from script.NumberValue.
+type ScriptNumberValue struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ScriptBooleanValue is generated from script.BooleanValue.
+type ScriptBooleanValue struct {
+	Type  string `json:"type"`
+	Value bool   `json:"value"`
+}
+
+// ScriptBigIntValue is generated from script.BigIntValue.
+type ScriptBigIntValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ScriptRealmType is generated from script.RealmType.
+type ScriptRealmType string
+
+const (
+	ScriptRealmTypeWindow          ScriptRealmType = "window"
+	ScriptRealmTypeDedicatedWorker ScriptRealmType = "dedicated-worker"
+	ScriptRealmTypeSharedWorker    ScriptRealmType = "shared-worker"
+	ScriptRealmTypeServiceWorker   ScriptRealmType = "service-worker"
+	ScriptRealmTypeWorker          ScriptRealmType = "worker"
+	ScriptRealmTypePaintWorklet    ScriptRealmType = "paint-worklet"
+	ScriptRealmTypeAudioWorklet    ScriptRealmType = "audio-worklet"
+	ScriptRealmTypeWorklet         ScriptRealmType = "worklet"
+)
+
+// ScriptRemoteReference is generated from script.RemoteReference.
+type ScriptRemoteReference struct {
+	SharedID ScriptSharedID `json:"sharedId,omitempty"`
+	Handle   ScriptHandle   `json:"handle,omitempty"`
+}
+
+// ScriptSharedID is generated from script.SharedId.
+type ScriptSharedID string
+
+// ScriptSharedReference is generated from script.SharedReference.
+type ScriptSharedReference struct {
+	SharedID ScriptSharedID `json:"sharedId"`
+	Handle   ScriptHandle   `json:"handle,omitempty"`
+}
+
+// ScriptRemoteObjectReference is generated from script.RemoteObjectReference.
+type ScriptRemoteObjectReference struct {
+	Handle   ScriptHandle   `json:"handle"`
+	SharedID ScriptSharedID `json:"sharedId,omitempty"`
+}
+
+// ScriptResultOwnership is generated from script.ResultOwnership.
+type ScriptResultOwnership string
+
+const (
+	ScriptResultOwnershipRoot ScriptResultOwnership = "root"
+	ScriptResultOwnershipNone ScriptResultOwnership = "none"
+)
+
+// ScriptSerializationOptions is generated from script.SerializationOptions.
+type ScriptSerializationOptions struct {
+	MaxDOMDepth       *int64 `json:"maxDomDepth,omitempty"`
+	MaxObjectDepth    *int64 `json:"maxObjectDepth,omitempty"`
+	IncludeShadowTree string `json:"includeShadowTree,omitempty"`
+}
+
+// ScriptTarget is generated from script.Target.
+type ScriptTarget struct {
+	Context BrowsingContextBrowsingContext `json:"context,omitempty"`
+	Sandbox string                         `json:"sandbox,omitempty"`
+	Realm   ScriptRealm                    `json:"realm,omitempty"`
+}
+
+// ScriptRealmTarget is generated from script.RealmTarget.
+type ScriptRealmTarget struct {
+	Realm ScriptRealm `json:"realm"`
+}
+
+// ScriptAddPreloadScriptParameters is generated from script.AddPreloadScriptParameters.
+type ScriptAddPreloadScriptParameters struct {
+	FunctionDeclaration string                           `json:"functionDeclaration"`
+	Arguments           []ScriptChannelValue             `json:"arguments,omitempty"`
+	Contexts            []BrowsingContextBrowsingContext `json:"contexts,omitempty"`
+	UserContexts        []BrowserUserContext             `json:"userContexts,omitempty"`
+	Sandbox             string                           `json:"sandbox,omitempty"`
+}
+
+// ScriptDisownParameters is generated from script.DisownParameters.
+type ScriptDisownParameters struct {
+	Handles []ScriptHandle `json:"handles"`
+	Target  ScriptTarget   `json:"target"`
+}
+
+// ScriptCallFunctionParameters is generated from script.CallFunctionParameters.
+type ScriptCallFunctionParameters struct {
+	FunctionDeclaration  string                      `json:"functionDeclaration"`
+	AwaitPromise         bool                        `json:"awaitPromise"`
+	Target               ScriptTarget                `json:"target"`
+	Arguments            []ScriptLocalValue          `json:"arguments,omitempty"`
+	ResultOwnership      ScriptResultOwnership       `json:"resultOwnership,omitempty"`
+	SerializationOptions *ScriptSerializationOptions `json:"serializationOptions,omitempty"`
+	This                 *ScriptLocalValue           `json:"this,omitempty"`
+	UserActivation       *bool                       `json:"userActivation,omitempty"`
+}
+
+// ScriptEvaluateParameters is generated from script.EvaluateParameters.
+type ScriptEvaluateParameters struct {
+	Expression           string                      `json:"expression"`
+	Target               ScriptTarget                `json:"target"`
+	AwaitPromise         bool                        `json:"awaitPromise"`
+	ResultOwnership      ScriptResultOwnership       `json:"resultOwnership,omitempty"`
+	SerializationOptions *ScriptSerializationOptions `json:"serializationOptions,omitempty"`
+	UserActivation       *bool                       `json:"userActivation,omitempty"`
+}
+
+// ScriptGetRealmsParameters is generated from script.GetRealmsParameters.
+type ScriptGetRealmsParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context,omitempty"`
+	Type    ScriptRealmType                `json:"type,omitempty"`
+}
+
+// ScriptRemovePreloadScriptParameters is generated from script.RemovePreloadScriptParameters.
+type ScriptRemovePreloadScriptParameters struct {
+	Script ScriptPreloadScript `json:"script"`
+}
+
+// StoragePartitionKey is generated from storage.PartitionKey.
+type StoragePartitionKey struct {
+	UserContext  string `json:"userContext,omitempty"`
+	SourceOrigin string `json:"sourceOrigin,omitempty"`
+}
+
+// StorageCookieFilter is generated from storage.CookieFilter.
+type StorageCookieFilter struct {
+	Name     string             `json:"name,omitempty"`
+	Value    *NetworkBytesValue `json:"value,omitempty"`
+	Domain   string             `json:"domain,omitempty"`
+	Path     string             `json:"path,omitempty"`
+	Size     *int64             `json:"size,omitempty"`
+	HTTPOnly *bool              `json:"httpOnly,omitempty"`
+	Secure   *bool              `json:"secure,omitempty"`
+	SameSite NetworkSameSite    `json:"sameSite,omitempty"`
+	Expiry   *int64             `json:"expiry,omitempty"`
+}
+
+// StorageBrowsingContextPartitionDescriptor is generated from storage.BrowsingContextPartitionDescriptor.
+type StorageBrowsingContextPartitionDescriptor struct {
+	Type    string                         `json:"type"`
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// StorageStorageKeyPartitionDescriptor is generated from storage.StorageKeyPartitionDescriptor.
+type StorageStorageKeyPartitionDescriptor struct {
+	Type         string `json:"type"`
+	UserContext  string `json:"userContext,omitempty"`
+	SourceOrigin string `json:"sourceOrigin,omitempty"`
+}
+
+// StoragePartitionDescriptor is generated from storage.PartitionDescriptor.
+type StoragePartitionDescriptor struct {
+	Type         string                         `json:"type"`
+	Context      BrowsingContextBrowsingContext `json:"context,omitempty"`
+	UserContext  string                         `json:"userContext,omitempty"`
+	SourceOrigin string                         `json:"sourceOrigin,omitempty"`
+}
+
+// StorageGetCookiesParameters is generated from storage.GetCookiesParameters.
+type StorageGetCookiesParameters struct {
+	Filter    *StorageCookieFilter        `json:"filter,omitempty"`
+	Partition *StoragePartitionDescriptor `json:"partition,omitempty"`
+}
+
+// StoragePartialCookie is generated from storage.PartialCookie.
+type StoragePartialCookie struct {
+	Name     string            `json:"name"`
+	Value    NetworkBytesValue `json:"value"`
+	Domain   string            `json:"domain"`
+	Path     string            `json:"path,omitempty"`
+	HTTPOnly *bool             `json:"httpOnly,omitempty"`
+	Secure   *bool             `json:"secure,omitempty"`
+	SameSite NetworkSameSite   `json:"sameSite,omitempty"`
+	Expiry   *int64            `json:"expiry,omitempty"`
+}
+
+// StorageSetCookieParameters is generated from storage.SetCookieParameters.
+type StorageSetCookieParameters struct {
+	Cookie    StoragePartialCookie        `json:"cookie"`
+	Partition *StoragePartitionDescriptor `json:"partition,omitempty"`
+}
+
+// StorageDeleteCookiesParameters is generated from storage.DeleteCookiesParameters.
+type StorageDeleteCookiesParameters struct {
+	Filter    *StorageCookieFilter        `json:"filter,omitempty"`
+	Partition *StoragePartitionDescriptor `json:"partition,omitempty"`
+}
+
+// InputElementOrigin is generated from input.ElementOrigin.
+type InputElementOrigin struct {
+	Type    string                `json:"type"`
+	Element ScriptSharedReference `json:"element"`
+}
+
+// InputPerformActionsParameters is generated from input.PerformActionsParameters.
+type InputPerformActionsParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Actions []InputSourceActions           `json:"actions"`
+}
+
+// InputSourceActions is generated from input.SourceActions.
+type InputSourceActions struct {
+	Type       string                  `json:"type"`
+	ID         string                  `json:"id"`
+	Actions    json.RawMessage         `json:"actions"`
+	Parameters *InputPointerParameters `json:"parameters,omitempty"`
+}
+
+// InputNoneSourceActions is generated from input.NoneSourceActions.
+type InputNoneSourceActions struct {
+	Type    string                  `json:"type"`
+	ID      string                  `json:"id"`
+	Actions []InputNoneSourceAction `json:"actions"`
+}
+
+// InputNoneSourceAction is generated from input.NoneSourceAction.
+type InputNoneSourceAction = InputPauseAction
+
+// InputKeySourceActions is generated from input.KeySourceActions.
+type InputKeySourceActions struct {
+	Type    string                 `json:"type"`
+	ID      string                 `json:"id"`
+	Actions []InputKeySourceAction `json:"actions"`
+}
+
+// InputKeySourceAction is generated from input.KeySourceAction.
+type InputKeySourceAction struct {
+	Type     string `json:"type"`
+	Duration *int64 `json:"duration,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// InputPointerSourceActions is generated from input.PointerSourceActions.
+type InputPointerSourceActions struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Parameters *InputPointerParameters    `json:"parameters,omitempty"`
+	Actions    []InputPointerSourceAction `json:"actions"`
+}
+
+// InputPointerType is generated from input.PointerType.
+type InputPointerType string
+
+const (
+	InputPointerTypeMouse InputPointerType = "mouse"
+	InputPointerTypePen   InputPointerType = "pen"
+	InputPointerTypeTouch InputPointerType = "touch"
+)
+
+// InputPointerParameters is generated from input.PointerParameters.
+type InputPointerParameters struct {
+	PointerType InputPointerType `json:"pointerType,omitempty"`
+}
+
+// InputPointerSourceAction is generated from input.PointerSourceAction.
+type InputPointerSourceAction struct {
+	Type               string      `json:"type"`
+	Duration           *int64      `json:"duration,omitempty"`
+	Button             *int64      `json:"button,omitempty"`
+	Width              *int64      `json:"width,omitempty"`
+	Height             *int64      `json:"height,omitempty"`
+	Pressure           *float64    `json:"pressure,omitempty"`
+	TangentialPressure *float64    `json:"tangentialPressure,omitempty"`
+	Twist              *int64      `json:"twist,omitempty"`
+	AltitudeAngle      *float64    `json:"altitudeAngle,omitempty"`
+	AzimuthAngle       *float64    `json:"azimuthAngle,omitempty"`
+	X                  *float64    `json:"x,omitempty"`
+	Y                  *float64    `json:"y,omitempty"`
+	Origin             InputOrigin `json:"origin,omitempty"`
+}
+
+// InputWheelSourceActions is generated from input.WheelSourceActions.
+type InputWheelSourceActions struct {
+	Type    string                   `json:"type"`
+	ID      string                   `json:"id"`
+	Actions []InputWheelSourceAction `json:"actions"`
+}
+
+// InputWheelSourceAction is generated from input.WheelSourceAction.
+type InputWheelSourceAction struct {
+	Type     string      `json:"type"`
+	Duration *int64      `json:"duration,omitempty"`
+	X        *int64      `json:"x,omitempty"`
+	Y        *int64      `json:"y,omitempty"`
+	DeltaX   *int64      `json:"deltaX,omitempty"`
+	DeltaY   *int64      `json:"deltaY,omitempty"`
+	Origin   InputOrigin `json:"origin,omitempty"`
+}
+
+// InputPauseAction is generated from input.PauseAction.
+type InputPauseAction struct {
+	Type     string `json:"type"`
+	Duration *int64 `json:"duration,omitempty"`
+}
+
+// InputKeyDownAction is generated from input.KeyDownAction.
+type InputKeyDownAction struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// InputKeyUpAction is generated from input.KeyUpAction.
+type InputKeyUpAction struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// InputPointerUpAction is generated from input.PointerUpAction.
+type InputPointerUpAction struct {
+	Type   string `json:"type"`
+	Button int64  `json:"button"`
+}
+
+// InputPointerDownAction is generated from input.PointerDownAction.
+type InputPointerDownAction struct {
+	Type               string   `json:"type"`
+	Button             int64    `json:"button"`
+	Width              *int64   `json:"width,omitempty"`
+	Height             *int64   `json:"height,omitempty"`
+	Pressure           *float64 `json:"pressure,omitempty"`
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	Twist              *int64   `json:"twist,omitempty"`
+	AltitudeAngle      *float64 `json:"altitudeAngle,omitempty"`
+	AzimuthAngle       *float64 `json:"azimuthAngle,omitempty"`
+}
+
+// InputPointerMoveAction is generated from input.PointerMoveAction.
+type InputPointerMoveAction struct {
+	Type               string      `json:"type"`
+	X                  float64     `json:"x"`
+	Y                  float64     `json:"y"`
+	Duration           *int64      `json:"duration,omitempty"`
+	Origin             InputOrigin `json:"origin,omitempty"`
+	Width              *int64      `json:"width,omitempty"`
+	Height             *int64      `json:"height,omitempty"`
+	Pressure           *float64    `json:"pressure,omitempty"`
+	TangentialPressure *float64    `json:"tangentialPressure,omitempty"`
+	Twist              *int64      `json:"twist,omitempty"`
+	AltitudeAngle      *float64    `json:"altitudeAngle,omitempty"`
+	AzimuthAngle       *float64    `json:"azimuthAngle,omitempty"`
+}
+
+// InputWheelScrollAction is generated from input.WheelScrollAction.
+type InputWheelScrollAction struct {
+	Type     string      `json:"type"`
+	X        int64       `json:"x"`
+	Y        int64       `json:"y"`
+	DeltaX   int64       `json:"deltaX"`
+	DeltaY   int64       `json:"deltaY"`
+	Duration *int64      `json:"duration,omitempty"`
+	Origin   InputOrigin `json:"origin,omitempty"`
+}
+
+// InputPointerCommonProperties is generated from input.PointerCommonProperties.
+type InputPointerCommonProperties struct {
+	Width              *int64   `json:"width,omitempty"`
+	Height             *int64   `json:"height,omitempty"`
+	Pressure           *float64 `json:"pressure,omitempty"`
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	Twist              *int64   `json:"twist,omitempty"`
+	AltitudeAngle      *float64 `json:"altitudeAngle,omitempty"`
+	AzimuthAngle       *float64 `json:"azimuthAngle,omitempty"`
+}
+
+// InputOrigin is generated from input.Origin.
+type InputOrigin = json.RawMessage
+
+// InputReleaseActionsParameters is generated from input.ReleaseActionsParameters.
+type InputReleaseActionsParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// InputSetFilesParameters is generated from input.SetFilesParameters.
+type InputSetFilesParameters struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Element ScriptSharedReference          `json:"element"`
+	Files   []string                       `json:"files"`
+}
+
+// WebExtensionExtension is generated from webExtension.Extension.
+type WebExtensionExtension string
+
+// WebExtensionInstallParameters is generated from webExtension.InstallParameters.
+type WebExtensionInstallParameters struct {
+	ExtensionData WebExtensionExtensionData `json:"extensionData"`
+}
+
+// WebExtensionExtensionData is generated from webExtension.ExtensionData.
+type WebExtensionExtensionData struct {
+	Type  string `json:"type"`
+	Path  string `json:"path,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// WebExtensionExtensionPath is generated from webExtension.ExtensionPath.
+type WebExtensionExtensionPath struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+// WebExtensionExtensionArchivePath is generated from webExtension.ExtensionArchivePath.
+type WebExtensionExtensionArchivePath struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+// WebExtensionExtensionBase64Encoded is generated from webExtension.ExtensionBase64Encoded.
+type WebExtensionExtensionBase64Encoded struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// WebExtensionUninstallParameters is generated from webExtension.UninstallParameters.
+type WebExtensionUninstallParameters struct {
+	Extension WebExtensionExtension `json:"extension"`
+}
+
+// Message is generated from Message.
+type Message struct {
+	Type       string          `json:"type"`
+	ID         *float64        `json:"id,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Error      ErrorCode       `json:"error,omitempty"`
+	Message    string          `json:"message,omitempty"`
+	Stacktrace string          `json:"stacktrace,omitempty"`
+	Method     string          `json:"method,omitempty"`
+	Params     json.RawMessage `json:"params,omitempty"`
+}
+
+// CommandResponse is generated from CommandResponse.
+type CommandResponse struct {
+	Type   string          `json:"type"`
+	ID     int64           `json:"id"`
+	Result json.RawMessage `json:"result"`
+}
+
+// ErrorResponse is generated from ErrorResponse.
+type ErrorResponse struct {
+	Type       string    `json:"type"`
+	ID         *int64    `json:"id"`
+	Error      ErrorCode `json:"error"`
+	Message    string    `json:"message"`
+	Stacktrace string    `json:"stacktrace,omitempty"`
+}
+
+// EmptyResult is generated from EmptyResult.
+type EmptyResult struct {
+}
+
+// Event is generated from Event.
+type Event struct {
+	Type   string          `json:"type"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// ErrorCode is generated from ErrorCode.
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidArgument                ErrorCode = "invalid argument"
+	ErrorCodeInvalidSelector                ErrorCode = "invalid selector"
+	ErrorCodeInvalidSessionID               ErrorCode = "invalid session id"
+	ErrorCodeInvalidWebExtension            ErrorCode = "invalid web extension"
+	ErrorCodeMoveTargetOutOfBounds          ErrorCode = "move target out of bounds"
+	ErrorCodeNoSuchAlert                    ErrorCode = "no such alert"
+	ErrorCodeNoSuchNetworkCollector         ErrorCode = "no such network collector"
+	ErrorCodeNoSuchElement                  ErrorCode = "no such element"
+	ErrorCodeNoSuchFrame                    ErrorCode = "no such frame"
+	ErrorCodeNoSuchHandle                   ErrorCode = "no such handle"
+	ErrorCodeNoSuchHistoryEntry             ErrorCode = "no such history entry"
+	ErrorCodeNoSuchIntercept                ErrorCode = "no such intercept"
+	ErrorCodeNoSuchNetworkData              ErrorCode = "no such network data"
+	ErrorCodeNoSuchNode                     ErrorCode = "no such node"
+	ErrorCodeNoSuchRequest                  ErrorCode = "no such request"
+	ErrorCodeNoSuchScript                   ErrorCode = "no such script"
+	ErrorCodeNoSuchStoragePartition         ErrorCode = "no such storage partition"
+	ErrorCodeNoSuchUserContext              ErrorCode = "no such user context"
+	ErrorCodeNoSuchWebExtension             ErrorCode = "no such web extension"
+	ErrorCodeSessionNotCreated              ErrorCode = "session not created"
+	ErrorCodeUnableToCaptureScreen          ErrorCode = "unable to capture screen"
+	ErrorCodeUnableToCloseBrowser           ErrorCode = "unable to close browser"
+	ErrorCodeUnableToSetCookie              ErrorCode = "unable to set cookie"
+	ErrorCodeUnableToSetFileInput           ErrorCode = "unable to set file input"
+	ErrorCodeUnavailableNetworkData         ErrorCode = "unavailable network data"
+	ErrorCodeUnderspecifiedStoragePartition ErrorCode = "underspecified storage partition"
+	ErrorCodeUnknownCommand                 ErrorCode = "unknown command"
+	ErrorCodeUnknownError                   ErrorCode = "unknown error"
+	ErrorCodeUnsupportedOperation           ErrorCode = "unsupported operation"
+)
+
+// SessionStatusResult is generated from session.StatusResult.
+type SessionStatusResult struct {
+	Ready   bool   `json:"ready"`
+	Message string `json:"message"`
+}
+
+// SessionNewResult is generated from session.NewResult.
+type SessionNewResult struct {
+	SessionID    string                       `json:"sessionId"`
+	Capabilities SessionNewResultCapabilities `json:"capabilities"`
+}
+
+// SessionSubscribeResult is generated from session.SubscribeResult.
+type SessionSubscribeResult struct {
+	Subscription SessionSubscription `json:"subscription"`
+}
+
+// BrowserCreateUserContextResult is generated from browser.CreateUserContextResult.
+type BrowserCreateUserContextResult = BrowserUserContextInfo
+
+// BrowserGetClientWindowsResult is generated from browser.GetClientWindowsResult.
+type BrowserGetClientWindowsResult struct {
+	ClientWindows []BrowserClientWindowInfo `json:"clientWindows"`
+}
+
+// BrowserGetUserContextsResult is generated from browser.GetUserContextsResult.
+type BrowserGetUserContextsResult struct {
+	UserContexts []BrowserUserContextInfo `json:"userContexts"`
+}
+
+// BrowsingContextInfoList is generated from browsingContext.InfoList.
+type BrowsingContextInfoList []BrowsingContextInfo
+
+// BrowsingContextInfo is generated from browsingContext.Info.
+type BrowsingContextInfo struct {
+	Children       BrowsingContextInfoList         `json:"children"`
+	ClientWindow   BrowserClientWindow             `json:"clientWindow"`
+	Context        BrowsingContextBrowsingContext  `json:"context"`
+	OriginalOpener *BrowsingContextBrowsingContext `json:"originalOpener"`
+	URL            string                          `json:"url"`
+	UserContext    BrowserUserContext              `json:"userContext"`
+	Parent         *BrowsingContextBrowsingContext `json:"parent,omitempty"`
+}
+
+// BrowsingContextCaptureScreenshotResult is generated from browsingContext.CaptureScreenshotResult.
+type BrowsingContextCaptureScreenshotResult struct {
+	Data string `json:"data"`
+}
+
+// BrowsingContextCreateResult is generated from browsingContext.CreateResult.
+type BrowsingContextCreateResult struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// BrowsingContextGetTreeResult is generated from browsingContext.GetTreeResult.
+type BrowsingContextGetTreeResult struct {
+	Contexts BrowsingContextInfoList `json:"contexts"`
+}
+
+// BrowsingContextLocateNodesResult is generated from browsingContext.LocateNodesResult.
+type BrowsingContextLocateNodesResult struct {
+	Nodes []ScriptNodeRemoteValue `json:"nodes"`
+}
+
+// BrowsingContextNavigateResult is generated from browsingContext.NavigateResult.
+type BrowsingContextNavigateResult struct {
+	Navigation *BrowsingContextNavigation `json:"navigation"`
+	URL        string                     `json:"url"`
+}
+
+// BrowsingContextPrintResult is generated from browsingContext.PrintResult.
+type BrowsingContextPrintResult struct {
+	Data string `json:"data"`
+}
+
+// BrowsingContextTraverseHistoryResult is generated from browsingContext.TraverseHistoryResult.
+type BrowsingContextTraverseHistoryResult struct {
+}
+
+// BrowsingContextNavigationInfo is generated from browsingContext.NavigationInfo.
+type BrowsingContextNavigationInfo struct {
+	Context    BrowsingContextBrowsingContext `json:"context"`
+	Navigation *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp  int64                          `json:"timestamp"`
+	URL        string                         `json:"url"`
+}
+
+// BrowsingContextHistoryUpdatedParameters is generated from browsingContext.HistoryUpdatedParameters.
+type BrowsingContextHistoryUpdatedParameters struct {
+	Context   BrowsingContextBrowsingContext `json:"context"`
+	Timestamp int64                          `json:"timestamp"`
+	URL       string                         `json:"url"`
+}
+
+// BrowsingContextDownloadWillBeginParams is generated from browsingContext.DownloadWillBeginParams.
+type BrowsingContextDownloadWillBeginParams struct {
+	SuggestedFilename string                         `json:"suggestedFilename"`
+	Context           BrowsingContextBrowsingContext `json:"context"`
+	Navigation        *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp         int64                          `json:"timestamp"`
+	URL               string                         `json:"url"`
+}
+
+// BrowsingContextBaseNavigationInfo is generated from browsingContext.BaseNavigationInfo.
+type BrowsingContextBaseNavigationInfo struct {
+	Context    BrowsingContextBrowsingContext `json:"context"`
+	Navigation *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp  int64                          `json:"timestamp"`
+	URL        string                         `json:"url"`
+}
+
+// BrowsingContextDownloadEndParams is generated from browsingContext.DownloadEndParams.
+type BrowsingContextDownloadEndParams struct {
+	Status     string                         `json:"status"`
+	Context    BrowsingContextBrowsingContext `json:"context"`
+	Navigation *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp  int64                          `json:"timestamp"`
+	URL        string                         `json:"url"`
+	Filepath   *string                        `json:"filepath,omitempty"`
+}
+
+// BrowsingContextDownloadCanceledParams is generated from browsingContext.DownloadCanceledParams.
+type BrowsingContextDownloadCanceledParams struct {
+	Status     string                         `json:"status"`
+	Context    BrowsingContextBrowsingContext `json:"context"`
+	Navigation *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp  int64                          `json:"timestamp"`
+	URL        string                         `json:"url"`
+}
+
+// BrowsingContextDownloadCompleteParams is generated from browsingContext.DownloadCompleteParams.
+type BrowsingContextDownloadCompleteParams struct {
+	Status     string                         `json:"status"`
+	Filepath   *string                        `json:"filepath"`
+	Context    BrowsingContextBrowsingContext `json:"context"`
+	Navigation *BrowsingContextNavigation     `json:"navigation"`
+	Timestamp  int64                          `json:"timestamp"`
+	URL        string                         `json:"url"`
+}
+
+// BrowsingContextUserPromptClosedParameters is generated from browsingContext.UserPromptClosedParameters.
+type BrowsingContextUserPromptClosedParameters struct {
+	Context  BrowsingContextBrowsingContext `json:"context"`
+	Accepted bool                           `json:"accepted"`
+	Type     BrowsingContextUserPromptType  `json:"type"`
+	UserText string                         `json:"userText,omitempty"`
+}
+
+// BrowsingContextUserPromptOpenedParameters is generated from browsingContext.UserPromptOpenedParameters.
+type BrowsingContextUserPromptOpenedParameters struct {
+	Context      BrowsingContextBrowsingContext `json:"context"`
+	Handler      SessionUserPromptHandlerType   `json:"handler"`
+	Message      string                         `json:"message"`
+	Type         BrowsingContextUserPromptType  `json:"type"`
+	DefaultValue string                         `json:"defaultValue,omitempty"`
+}
+
+// EmulationSetGeolocationOverrideResult is generated from emulation.SetGeolocationOverrideResult.
+type EmulationSetGeolocationOverrideResult = EmptyResult
+
+// NetworkAddDataCollectorResult is generated from network.AddDataCollectorResult.
+type NetworkAddDataCollectorResult struct {
+	Collector NetworkCollector `json:"collector"`
+}
+
+// NetworkAddInterceptResult is generated from network.AddInterceptResult.
+type NetworkAddInterceptResult struct {
+	Intercept NetworkIntercept `json:"intercept"`
+}
+
+// NetworkGetDataResult is generated from network.GetDataResult.
+type NetworkGetDataResult struct {
+	Bytes NetworkBytesValue `json:"bytes"`
+}
+
+// NetworkAuthChallenge is generated from network.AuthChallenge.
+type NetworkAuthChallenge struct {
+	Scheme string `json:"scheme"`
+	Realm  string `json:"realm"`
+}
+
+// NetworkBaseParameters is generated from network.BaseParameters.
+type NetworkBaseParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+}
+
+// NetworkCookie is generated from network.Cookie.
+type NetworkCookie struct {
+	Name     string            `json:"name"`
+	Value    NetworkBytesValue `json:"value"`
+	Domain   string            `json:"domain"`
+	Path     string            `json:"path"`
+	Size     int64             `json:"size"`
+	HTTPOnly bool              `json:"httpOnly"`
+	Secure   bool              `json:"secure"`
+	SameSite NetworkSameSite   `json:"sameSite"`
+	Expiry   *int64            `json:"expiry,omitempty"`
+}
+
+// NetworkFetchTimingInfo is generated from network.FetchTimingInfo.
+type NetworkFetchTimingInfo struct {
+	TimeOrigin    float64 `json:"timeOrigin"`
+	RequestTime   float64 `json:"requestTime"`
+	RedirectStart float64 `json:"redirectStart"`
+	RedirectEnd   float64 `json:"redirectEnd"`
+	FetchStart    float64 `json:"fetchStart"`
+	DNSStart      float64 `json:"dnsStart"`
+	DNSEnd        float64 `json:"dnsEnd"`
+	ConnectStart  float64 `json:"connectStart"`
+	ConnectEnd    float64 `json:"connectEnd"`
+	TLSStart      float64 `json:"tlsStart"`
+	RequestStart  float64 `json:"requestStart"`
+	ResponseStart float64 `json:"responseStart"`
+	ResponseEnd   float64 `json:"responseEnd"`
+}
+
+// NetworkInitiator is generated from network.Initiator.
+type NetworkInitiator struct {
+	ColumnNumber *int64            `json:"columnNumber,omitempty"`
+	LineNumber   *int64            `json:"lineNumber,omitempty"`
+	Request      NetworkRequest    `json:"request,omitempty"`
+	StackTrace   *ScriptStackTrace `json:"stackTrace,omitempty"`
+	Type         string            `json:"type,omitempty"`
+}
+
+// NetworkRequestData is generated from network.RequestData.
+type NetworkRequestData struct {
+	Request       NetworkRequest         `json:"request"`
+	URL           string                 `json:"url"`
+	Method        string                 `json:"method"`
+	Headers       []NetworkHeader        `json:"headers"`
+	Cookies       []NetworkCookie        `json:"cookies"`
+	HeadersSize   int64                  `json:"headersSize"`
+	BodySize      *int64                 `json:"bodySize"`
+	Destination   string                 `json:"destination"`
+	InitiatorType *string                `json:"initiatorType"`
+	Timings       NetworkFetchTimingInfo `json:"timings"`
+}
+
+// NetworkResponseContent is generated from network.ResponseContent.
+type NetworkResponseContent struct {
+	Size int64 `json:"size"`
+}
+
+// NetworkResponseData is generated from network.ResponseData.
+type NetworkResponseData struct {
+	URL            string                 `json:"url"`
+	Protocol       string                 `json:"protocol"`
+	Status         int64                  `json:"status"`
+	StatusText     string                 `json:"statusText"`
+	FromCache      bool                   `json:"fromCache"`
+	Headers        []NetworkHeader        `json:"headers"`
+	MimeType       string                 `json:"mimeType"`
+	BytesReceived  int64                  `json:"bytesReceived"`
+	HeadersSize    *int64                 `json:"headersSize"`
+	BodySize       *int64                 `json:"bodySize"`
+	Content        NetworkResponseContent `json:"content"`
+	AuthChallenges []NetworkAuthChallenge `json:"authChallenges,omitempty"`
+}
+
+// NetworkAuthRequiredParameters is generated from network.AuthRequiredParameters.
+type NetworkAuthRequiredParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+	Response      NetworkResponseData             `json:"response"`
+}
+
+// NetworkBeforeRequestSentParameters is generated from network.BeforeRequestSentParameters.
+type NetworkBeforeRequestSentParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+	Initiator     *NetworkInitiator               `json:"initiator,omitempty"`
+}
+
+// NetworkFetchErrorParameters is generated from network.FetchErrorParameters.
+type NetworkFetchErrorParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+	ErrorText     string                          `json:"errorText"`
+}
+
+// NetworkResponseCompletedParameters is generated from network.ResponseCompletedParameters.
+type NetworkResponseCompletedParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+	Response      NetworkResponseData             `json:"response"`
+}
+
+// NetworkResponseStartedParameters is generated from network.ResponseStartedParameters.
+type NetworkResponseStartedParameters struct {
+	Context       *BrowsingContextBrowsingContext `json:"context"`
+	IsBlocked     bool                            `json:"isBlocked"`
+	Navigation    *BrowsingContextNavigation      `json:"navigation"`
+	RedirectCount int64                           `json:"redirectCount"`
+	Request       NetworkRequestData              `json:"request"`
+	Timestamp     int64                           `json:"timestamp"`
+	Intercepts    []NetworkIntercept              `json:"intercepts,omitempty"`
+	Response      NetworkResponseData             `json:"response"`
+}
+
+// ScriptEvaluateResult is generated from script.EvaluateResult.
+type ScriptEvaluateResult struct {
+	Type             string                  `json:"type"`
+	Result           *ScriptRemoteValue      `json:"result,omitempty"`
+	Realm            ScriptRealm             `json:"realm"`
+	ExceptionDetails *ScriptExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// ScriptEvaluateResultSuccess is generated from script.EvaluateResultSuccess.
+type ScriptEvaluateResultSuccess struct {
+	Type   string            `json:"type"`
+	Result ScriptRemoteValue `json:"result"`
+	Realm  ScriptRealm       `json:"realm"`
+}
+
+// ScriptEvaluateResultException is generated from script.EvaluateResultException.
+type ScriptEvaluateResultException struct {
+	Type             string                 `json:"type"`
+	ExceptionDetails ScriptExceptionDetails `json:"exceptionDetails"`
+	Realm            ScriptRealm            `json:"realm"`
+}
+
+// ScriptExceptionDetails is generated from script.ExceptionDetails.
+type ScriptExceptionDetails struct {
+	ColumnNumber int64             `json:"columnNumber"`
+	Exception    ScriptRemoteValue `json:"exception"`
+	LineNumber   int64             `json:"lineNumber"`
+	StackTrace   ScriptStackTrace  `json:"stackTrace"`
+	Text         string            `json:"text"`
+}
+
+// ScriptRealmInfo is generated from script.RealmInfo.
+type ScriptRealmInfo struct {
+	Realm   ScriptRealm                    `json:"realm"`
+	Origin  string                         `json:"origin"`
+	Type    string                         `json:"type"`
+	Context BrowsingContextBrowsingContext `json:"context,omitempty"`
+	Sandbox string                         `json:"sandbox,omitempty"`
+	Owners  []ScriptRealm                  `json:"owners,omitempty"`
+}
+
+// ScriptBaseRealmInfo is generated from script.BaseRealmInfo.
+type ScriptBaseRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+}
+
+// ScriptWindowRealmInfo is generated from script.WindowRealmInfo.
+type ScriptWindowRealmInfo struct {
+	Realm   ScriptRealm                    `json:"realm"`
+	Origin  string                         `json:"origin"`
+	Type    string                         `json:"type"`
+	Context BrowsingContextBrowsingContext `json:"context"`
+	Sandbox string                         `json:"sandbox,omitempty"`
+}
+
+// ScriptDedicatedWorkerRealmInfo is generated from script.DedicatedWorkerRealmInfo.
+type ScriptDedicatedWorkerRealmInfo struct {
+	Realm  ScriptRealm   `json:"realm"`
+	Origin string        `json:"origin"`
+	Type   string        `json:"type"`
+	Owners []ScriptRealm `json:"owners"`
+}
+
+// ScriptSharedWorkerRealmInfo is generated from script.SharedWorkerRealmInfo.
+type ScriptSharedWorkerRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptServiceWorkerRealmInfo is generated from script.ServiceWorkerRealmInfo.
+type ScriptServiceWorkerRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptWorkerRealmInfo is generated from script.WorkerRealmInfo.
+type ScriptWorkerRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptPaintWorkletRealmInfo is generated from script.PaintWorkletRealmInfo.
+type ScriptPaintWorkletRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptAudioWorkletRealmInfo is generated from script.AudioWorkletRealmInfo.
+type ScriptAudioWorkletRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptWorkletRealmInfo is generated from script.WorkletRealmInfo.
+type ScriptWorkletRealmInfo struct {
+	Realm  ScriptRealm `json:"realm"`
+	Origin string      `json:"origin"`
+	Type   string      `json:"type"`
+}
+
+// ScriptRemoteValue is generated from script.RemoteValue.
+type ScriptRemoteValue struct {
+	Type       string           `json:"type"`
+	Value      json.RawMessage  `json:"value,omitempty"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+	SharedID   ScriptSharedID   `json:"sharedId,omitempty"`
+}
+
+// ScriptListRemoteValue is generated from script.ListRemoteValue.
+type ScriptListRemoteValue []ScriptRemoteValue
+
+// ScriptMappingRemoteValue is generated from script.MappingRemoteValue.
+type ScriptMappingRemoteValue [][]json.RawMessage
+
+// ScriptSymbolRemoteValue is generated from script.SymbolRemoteValue.
+type ScriptSymbolRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptArrayRemoteValue is generated from script.ArrayRemoteValue.
+type ScriptArrayRemoteValue struct {
+	Type       string                `json:"type"`
+	Handle     ScriptHandle          `json:"handle,omitempty"`
+	InternalID ScriptInternalID      `json:"internalId,omitempty"`
+	Value      ScriptListRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptObjectRemoteValue is generated from script.ObjectRemoteValue.
+type ScriptObjectRemoteValue struct {
+	Type       string                   `json:"type"`
+	Handle     ScriptHandle             `json:"handle,omitempty"`
+	InternalID ScriptInternalID         `json:"internalId,omitempty"`
+	Value      ScriptMappingRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptFunctionRemoteValue is generated from script.FunctionRemoteValue.
+type ScriptFunctionRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptRegExpRemoteValue is generated from script.RegExpRemoteValue.
+type ScriptRegExpRemoteValue struct {
+	Type       string            `json:"type"`
+	Value      ScriptRegExpValue `json:"value"`
+	Handle     ScriptHandle      `json:"handle,omitempty"`
+	InternalID ScriptInternalID  `json:"internalId,omitempty"`
+}
+
+// ScriptDateRemoteValue is generated from script.DateRemoteValue.
+type ScriptDateRemoteValue struct {
+	Type       string           `json:"type"`
+	Value      string           `json:"value"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptMapRemoteValue is generated from script.MapRemoteValue.
+type ScriptMapRemoteValue struct {
+	Type       string                   `json:"type"`
+	Handle     ScriptHandle             `json:"handle,omitempty"`
+	InternalID ScriptInternalID         `json:"internalId,omitempty"`
+	Value      ScriptMappingRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptSetRemoteValue is generated from script.SetRemoteValue.
+type ScriptSetRemoteValue struct {
+	Type       string                `json:"type"`
+	Handle     ScriptHandle          `json:"handle,omitempty"`
+	InternalID ScriptInternalID      `json:"internalId,omitempty"`
+	Value      ScriptListRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptWeakMapRemoteValue is generated from script.WeakMapRemoteValue.
+type ScriptWeakMapRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptWeakSetRemoteValue is generated from script.WeakSetRemoteValue.
+type ScriptWeakSetRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptGeneratorRemoteValue is generated from script.GeneratorRemoteValue.
+type ScriptGeneratorRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptErrorRemoteValue is generated from script.ErrorRemoteValue.
+type ScriptErrorRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptProxyRemoteValue is generated from script.ProxyRemoteValue.
+type ScriptProxyRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptPromiseRemoteValue is generated from script.PromiseRemoteValue.
+type ScriptPromiseRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptTypedArrayRemoteValue is generated from script.TypedArrayRemoteValue.
+type ScriptTypedArrayRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptArrayBufferRemoteValue is generated from script.ArrayBufferRemoteValue.
+type ScriptArrayBufferRemoteValue struct {
+	Type       string           `json:"type"`
+	Handle     ScriptHandle     `json:"handle,omitempty"`
+	InternalID ScriptInternalID `json:"internalId,omitempty"`
+}
+
+// ScriptNodeListRemoteValue is generated from script.NodeListRemoteValue.
+type ScriptNodeListRemoteValue struct {
+	Type       string                `json:"type"`
+	Handle     ScriptHandle          `json:"handle,omitempty"`
+	InternalID ScriptInternalID      `json:"internalId,omitempty"`
+	Value      ScriptListRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptHTMLCollectionRemoteValue is generated from script.HTMLCollectionRemoteValue.
+type ScriptHTMLCollectionRemoteValue struct {
+	Type       string                `json:"type"`
+	Handle     ScriptHandle          `json:"handle,omitempty"`
+	InternalID ScriptInternalID      `json:"internalId,omitempty"`
+	Value      ScriptListRemoteValue `json:"value,omitempty"`
+}
+
+// ScriptNodeRemoteValue is generated from script.NodeRemoteValue.
+type ScriptNodeRemoteValue struct {
+	Type       string                `json:"type"`
+	SharedID   ScriptSharedID        `json:"sharedId,omitempty"`
+	Handle     ScriptHandle          `json:"handle,omitempty"`
+	InternalID ScriptInternalID      `json:"internalId,omitempty"`
+	Value      *ScriptNodeProperties `json:"value,omitempty"`
+}
+
+// ScriptNodeProperties is generated from script.NodeProperties.
+type ScriptNodeProperties struct {
+	NodeType       int64                   `json:"nodeType"`
+	ChildNodeCount int64                   `json:"childNodeCount"`
+	Attributes     map[string]string       `json:"attributes,omitempty"`
+	Children       []ScriptNodeRemoteValue `json:"children,omitempty"`
+	LocalName      string                  `json:"localName,omitempty"`
+	Mode           string                  `json:"mode,omitempty"`
+	NamespaceURI   string                  `json:"namespaceURI,omitempty"`
+	NodeValue      string                  `json:"nodeValue,omitempty"`
+	ShadowRoot     *ScriptNodeRemoteValue  `json:"shadowRoot,omitempty"`
+}
+
+// ScriptWindowProxyRemoteValue is generated from script.WindowProxyRemoteValue.
+type ScriptWindowProxyRemoteValue struct {
+	Type       string                      `json:"type"`
+	Value      ScriptWindowProxyProperties `json:"value"`
+	Handle     ScriptHandle                `json:"handle,omitempty"`
+	InternalID ScriptInternalID            `json:"internalId,omitempty"`
+}
+
+// ScriptWindowProxyProperties is generated from script.WindowProxyProperties.
+type ScriptWindowProxyProperties struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// ScriptSource is generated from script.Source.
+type ScriptSource struct {
+	Realm   ScriptRealm                    `json:"realm"`
+	Context BrowsingContextBrowsingContext `json:"context,omitempty"`
+}
+
+// ScriptStackFrame is generated from script.StackFrame.
+type ScriptStackFrame struct {
+	ColumnNumber int64  `json:"columnNumber"`
+	FunctionName string `json:"functionName"`
+	LineNumber   int64  `json:"lineNumber"`
+	URL          string `json:"url"`
+}
+
+// ScriptStackTrace is generated from script.StackTrace.
+type ScriptStackTrace struct {
+	CallFrames []ScriptStackFrame `json:"callFrames"`
+}
+
+// ScriptAddPreloadScriptResult is generated from script.AddPreloadScriptResult.
+type ScriptAddPreloadScriptResult struct {
+	Script ScriptPreloadScript `json:"script"`
+}
+
+// ScriptGetRealmsResult is generated from script.GetRealmsResult.
+type ScriptGetRealmsResult struct {
+	Realms []ScriptRealmInfo `json:"realms"`
+}
+
+// ScriptMessageParameters is generated from script.MessageParameters.
+type ScriptMessageParameters struct {
+	Channel ScriptChannel     `json:"channel"`
+	Data    ScriptRemoteValue `json:"data"`
+	Source  ScriptSource      `json:"source"`
+}
+
+// ScriptRealmDestroyedParameters is generated from script.RealmDestroyedParameters.
+type ScriptRealmDestroyedParameters struct {
+	Realm ScriptRealm `json:"realm"`
+}
+
+// StorageGetCookiesResult is generated from storage.GetCookiesResult.
+type StorageGetCookiesResult struct {
+	Cookies      []NetworkCookie     `json:"cookies"`
+	PartitionKey StoragePartitionKey `json:"partitionKey"`
+}
+
+// StorageSetCookieResult is generated from storage.SetCookieResult.
+type StorageSetCookieResult struct {
+	PartitionKey StoragePartitionKey `json:"partitionKey"`
+}
+
+// StorageDeleteCookiesResult is generated from storage.DeleteCookiesResult.
+type StorageDeleteCookiesResult struct {
+	PartitionKey StoragePartitionKey `json:"partitionKey"`
+}
+
+// LogLevel is generated from log.Level.
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "debug"
+	LogLevelInfo  LogLevel = "info"
+	LogLevelWarn  LogLevel = "warn"
+	LogLevelError LogLevel = "error"
+)
+
+// LogEntry is generated from log.Entry.
+type LogEntry struct {
+	Level      LogLevel            `json:"level"`
+	Source     ScriptSource        `json:"source"`
+	Text       *string             `json:"text"`
+	Timestamp  int64               `json:"timestamp"`
+	StackTrace *ScriptStackTrace   `json:"stackTrace,omitempty"`
+	Type       string              `json:"type"`
+	Method     string              `json:"method,omitempty"`
+	Args       []ScriptRemoteValue `json:"args,omitempty"`
+}
+
+// LogBaseLogEntry is generated from log.BaseLogEntry.
+type LogBaseLogEntry struct {
+	Level      LogLevel          `json:"level"`
+	Source     ScriptSource      `json:"source"`
+	Text       *string           `json:"text"`
+	Timestamp  int64             `json:"timestamp"`
+	StackTrace *ScriptStackTrace `json:"stackTrace,omitempty"`
+}
+
+// LogGenericLogEntry is generated from log.GenericLogEntry.
+type LogGenericLogEntry struct {
+	Level      LogLevel          `json:"level"`
+	Source     ScriptSource      `json:"source"`
+	Text       *string           `json:"text"`
+	Timestamp  int64             `json:"timestamp"`
+	StackTrace *ScriptStackTrace `json:"stackTrace,omitempty"`
+	Type       string            `json:"type"`
+}
+
+// LogConsoleLogEntry is generated from log.ConsoleLogEntry.
+type LogConsoleLogEntry struct {
+	Level      LogLevel            `json:"level"`
+	Source     ScriptSource        `json:"source"`
+	Text       *string             `json:"text"`
+	Timestamp  int64               `json:"timestamp"`
+	StackTrace *ScriptStackTrace   `json:"stackTrace,omitempty"`
+	Type       string              `json:"type"`
+	Method     string              `json:"method"`
+	Args       []ScriptRemoteValue `json:"args"`
+}
+
+// LogJavascriptLogEntry is generated from log.JavascriptLogEntry.
+type LogJavascriptLogEntry struct {
+	Level      LogLevel          `json:"level"`
+	Source     ScriptSource      `json:"source"`
+	Text       *string           `json:"text"`
+	Timestamp  int64             `json:"timestamp"`
+	StackTrace *ScriptStackTrace `json:"stackTrace,omitempty"`
+	Type       string            `json:"type"`
+}
+
+// WebExtensionInstallResult is generated from webExtension.InstallResult.
+type WebExtensionInstallResult struct {
+	Extension WebExtensionExtension `json:"extension"`
+}
+
+// BrowsingContextAccessibilityLocatorValue is generated from an inline map.
+type BrowsingContextAccessibilityLocatorValue struct {
+	Name string `json:"name,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+// BrowsingContextContextLocatorValue is generated from an inline map.
+type BrowsingContextContextLocatorValue struct {
+	Context BrowsingContextBrowsingContext `json:"context"`
+}
+
+// SessionNewResultCapabilities is generated from an inline map.
+type SessionNewResultCapabilities struct {
+	AcceptInsecureCerts     bool                       `json:"acceptInsecureCerts"`
+	BrowserName             string                     `json:"browserName"`
+	BrowserVersion          string                     `json:"browserVersion"`
+	PlatformName            string                     `json:"platformName"`
+	SetWindowRect           bool                       `json:"setWindowRect"`
+	UserAgent               string                     `json:"userAgent"`
+	Proxy                   *SessionProxyConfiguration `json:"proxy,omitempty"`
+	UnhandledPromptBehavior *SessionUserPromptHandler  `json:"unhandledPromptBehavior,omitempty"`
+	WebSocketURL            string                     `json:"webSocketUrl,omitempty"`
+}
@@ -0,0 +1,174 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"nhooyr.io/websocket"
+)
+
+// testRemote is the browser side of a pipe. It answers every command with the result of handle
+// and records the commands.
+type testRemote struct {
+	transport Transport
+	handle    func(method string, params json.RawMessage) (interface{}, error)
+	mu        sync.Mutex
+	commands  []testCommand
+}
+
+type testCommand struct {
+	Method string
+	Params json.RawMessage
+}
+
+// newTestSession returns a session connected to a scripted remote end. A nil handle answers every
+// command with an empty result.
+func newTestSession(t *testing.T, handle func(method string, params json.RawMessage) (interface{}, error)) (*Session, *testRemote) {
+	local, remote := NewPipe()
+
+	r := &testRemote{transport: remote, handle: handle}
+	go r.serve()
+
+	session := NewWithTransport(local)
+	t.Cleanup(func() { _ = session.Close() })
+
+	return session, r
+}
+
+// newTestServer returns the URL of a websocket endpoint that serves a scripted remote end, and the
+// header of the handshake once a client has connected.
+func newTestServer(t *testing.T, handle func(method string, params json.RawMessage) (interface{}, error)) (string, *testRemote, <-chan http.Header) {
+	r := &testRemote{handle: handle}
+	header := make(chan http.Header, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c, err := websocket.Accept(w, req, nil)
+		if err != nil {
+			return
+		}
+
+		defer c.Close(websocket.StatusNormalClosure, "")
+
+		header <- req.Header
+
+		r.transport = &testConn{conn: c}
+		r.serve()
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http"), r, header
+}
+
+// testConn is the server side of a websocket connection.
+type testConn struct {
+	conn *websocket.Conn
+}
+
+func (c *testConn) Read(ctx context.Context) ([]byte, error) {
+	_, data, err := c.conn.Read(ctx)
+	return data, err
+}
+
+func (c *testConn) Write(ctx context.Context, p []byte) error {
+	return c.conn.Write(ctx, websocket.MessageText, p)
+}
+
+func (c *testConn) Close() error {
+	return c.conn.Close(websocket.StatusNormalClosure, "")
+}
+
+func (r *testRemote) serve() {
+	for {
+		data, err := r.transport.Read(context.Background())
+		if err != nil {
+			return
+		}
+
+		cmd := struct {
+			ID     int             `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}{}
+
+		if err := json.Unmarshal(data, &cmd); err != nil {
+			return
+		}
+
+		r.mu.Lock()
+		r.commands = append(r.commands, testCommand{Method: cmd.Method, Params: cmd.Params})
+		r.mu.Unlock()
+
+		var result interface{} = map[string]interface{}{}
+
+		if r.handle != nil {
+			res, err := r.handle(cmd.Method, cmd.Params)
+			if err != nil {
+				r.write(map[string]interface{}{"type": "error", "id": cmd.ID, "error": "unknown error", "message": err.Error()})
+				continue
+			}
+
+			if res != nil {
+				result = res
+			}
+		}
+
+		r.write(map[string]interface{}{"type": "success", "id": cmd.ID, "result": result})
+	}
+}
+
+// emit sends an event to the client.
+func (r *testRemote) emit(method string, params interface{}) {
+	r.write(map[string]interface{}{"type": "event", "method": method, "params": params})
+}
+
+func (r *testRemote) write(msg interface{}) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	_ = r.transport.Write(context.Background(), data)
+}
+
+// params returns the params of the commands with the method in the order they were received.
+func (r *testRemote) params(method string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var params []string
+
+	for _, cmd := range r.commands {
+		if cmd.Method == method {
+			params = append(params, string(cmd.Params))
+		}
+	}
+
+	return params
+}
+
+// subscriptionResult answers session.subscribe with sequential subscription IDs.
+func subscriptionResult() func(method string, params json.RawMessage) (interface{}, error) {
+	var (
+		mu sync.Mutex
+		n  int
+	)
+
+	return func(method string, params json.RawMessage) (interface{}, error) {
+		if method != "session.subscribe" {
+			return nil, nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		n++
+
+		return map[string]string{"subscription": fmt.Sprintf("sub-%d", n)}, nil
+	}
+}
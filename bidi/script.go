@@ -1,5 +1,11 @@
 package bidi
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
 type Source struct {
 	Realm   string `json:"realm"`
 	Context string `json:"context"`
@@ -15,3 +21,183 @@ type StackFrame struct {
 type StackTrace struct {
 	CallFrames []*StackFrame `json:"callFrames"`
 }
+
+type ChannelValue struct {
+	Type  string `json:"type"`
+	Value struct {
+		Channel string `json:"channel"`
+	} `json:"value"`
+}
+
+func NewChannelValue(channel string) ChannelValue {
+	v := ChannelValue{Type: "channel"}
+	v.Value.Channel = channel
+
+	return v
+}
+
+type PreloadScript struct {
+	ID     string  `json:"script"`
+	client *Client `json:"-"`
+}
+
+type AddPreloadScriptOptions struct {
+	// Channel arguments passed to the function declaration
+	Arguments []ChannelValue
+
+	// Browsing contexts the script is restricted to. If empty, the script applies to all contexts.
+	Contexts []string
+
+	// Sandbox in which the script is evaluated
+	Sandbox string
+}
+
+// AddPreloadScript adds a script that is evaluated in every new realm before any page script runs.
+func (s *Session) AddPreloadScript(functionDeclaration string, optFns ...func(o *AddPreloadScriptOptions)) (*PreloadScript, error) {
+	opts := AddPreloadScriptOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"functionDeclaration": functionDeclaration,
+	}
+
+	if len(opts.Arguments) > 0 {
+		params["arguments"] = opts.Arguments
+	}
+
+	if len(opts.Contexts) > 0 {
+		params["contexts"] = opts.Contexts
+	}
+
+	if opts.Sandbox != "" {
+		params["sandbox"] = opts.Sandbox
+	}
+
+	data, err := s.client.Call(context.Background(), "script.addPreloadScript", params)
+	if err != nil {
+		return nil, err
+	}
+
+	script := &PreloadScript{}
+	if err := json.Unmarshal(data, script); err != nil {
+		return nil, err
+	}
+
+	script.client = s.client
+
+	return script, nil
+}
+
+// Remove removes the preload script.
+func (p *PreloadScript) Remove() error {
+	_, err := p.client.Call(context.Background(), "script.removePreloadScript", map[string]interface{}{
+		"script": p.ID,
+	})
+
+	return err
+}
+
+// Message is emitted when a preload script sends data through a channel.
+type Message struct {
+	Channel string          `json:"channel"`
+	Data    json.RawMessage `json:"data"`
+	Source  Source          `json:"source"`
+}
+
+type ScriptOptions struct {
+	// AwaitPromise waits for a returned promise to settle and returns its value. Defaults to true.
+	AwaitPromise bool
+
+	// Sandbox in which the script is evaluated
+	Sandbox string
+
+	// UserActivation treats the evaluation as if it was triggered by the user
+	UserActivation bool
+}
+
+// ExceptionDetails is returned as error when a script throws.
+type ExceptionDetails struct {
+	ColumnNumber int          `json:"columnNumber"`
+	Exception    *RemoteValue `json:"exception"`
+	LineNumber   int          `json:"lineNumber"`
+	StackTrace   StackTrace   `json:"stackTrace"`
+	Text         string       `json:"text"`
+}
+
+func (e *ExceptionDetails) Error() string {
+	return fmt.Sprintf("script exception at %d:%d: %s", e.LineNumber, e.ColumnNumber, e.Text)
+}
+
+// Evaluate evaluates the expression in the browsing context and returns its value.
+func (b *BrowsingContext) Evaluate(expression string, optFns ...func(o *ScriptOptions)) (*RemoteValue, error) {
+	params := map[string]interface{}{
+		"expression": expression,
+	}
+
+	return b.runScript("script.evaluate", params, optFns)
+}
+
+// CallFunction calls the function declaration in the browsing context with the arguments
+// serialized as local values and returns its value.
+func (b *BrowsingContext) CallFunction(functionDeclaration string, args []interface{}, optFns ...func(o *ScriptOptions)) (*RemoteValue, error) {
+	arguments := make([]LocalValue, 0, len(args))
+	for _, arg := range args {
+		arguments = append(arguments, NewLocalValue(arg))
+	}
+
+	params := map[string]interface{}{
+		"functionDeclaration": functionDeclaration,
+		"arguments":           arguments,
+	}
+
+	return b.runScript("script.callFunction", params, optFns)
+}
+
+func (b *BrowsingContext) runScript(method string, params map[string]interface{}, optFns []func(o *ScriptOptions)) (*RemoteValue, error) {
+	opts := ScriptOptions{
+		AwaitPromise: true,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	target := map[string]interface{}{
+		"context": b.ID,
+	}
+
+	if opts.Sandbox != "" {
+		target["sandbox"] = opts.Sandbox
+	}
+
+	params["target"] = target
+	params["awaitPromise"] = opts.AwaitPromise
+
+	if opts.UserActivation {
+		params["userActivation"] = true
+	}
+
+	data, err := b.client.Call(context.Background(), method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		Type             string            `json:"type"`
+		Result           *RemoteValue      `json:"result"`
+		ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	}{}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Type == "exception" {
+		return nil, result.ExceptionDetails
+	}
+
+	return result.Result, nil
+}
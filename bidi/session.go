@@ -4,15 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
 )
 
 type Session struct {
-	ID     string
-	client *Client `json:"-"`
+	ID                     string
+	Capabilities           *SessionCapabilities
+	client                 *Client `json:"-"`
+	bindingsMu             sync.RWMutex
+	bindings               map[string]*Binding
+	bindingsSubscriptionMu sync.Mutex
+	bindingsSubscription   *Subscription
+	bindingsRemove         func()
+	promptsMu              sync.Mutex
+	prompts                *userPromptHandler
 }
 
-func New(wsURL string, header http.Header) (*Session, error) {
-	client := NewBiDiClient()
+func New(wsURL string, header http.Header, optFns ...func(o *WebSocketOptions)) (*Session, error) {
+	client := NewBiDiClient(optFns...)
 
 	if err := client.Start(wsURL, header); err != nil {
 		return nil, err
@@ -23,6 +32,100 @@ func New(wsURL string, header http.Header) (*Session, error) {
 	}, nil
 }
 
+// NewWithTransport returns a session that communicates over a connected transport, for example
+// one end of NewPipe.
+func NewWithTransport(transport Transport) *Session {
+	return &Session{
+		client: NewClient(transport),
+	}
+}
+
+// CapabilitiesRequest defines the capabilities the remote end has to match when creating a new session.
+//
+// See: https://w3c.github.io/webdriver-bidi/#module-session-CapabilitiesRequest
+type CapabilitiesRequest struct {
+	AlwaysMatch map[string]interface{}   `json:"alwaysMatch,omitempty"`
+	FirstMatch  []map[string]interface{} `json:"firstMatch,omitempty"`
+}
+
+// SessionCapabilities are the capabilities of a session created with session.new.
+type SessionCapabilities struct {
+	AcceptInsecureCerts bool                   `json:"acceptInsecureCerts"`
+	BrowserName         string                 `json:"browserName"`
+	BrowserVersion      string                 `json:"browserVersion"`
+	PlatformName        string                 `json:"platformName"`
+	SetWindowRect       bool                   `json:"setWindowRect"`
+	UserAgent           string                 `json:"userAgent"`
+	Proxy               map[string]interface{} `json:"proxy,omitempty"`
+	WebSocketURL        string                 `json:"webSocketUrl,omitempty"`
+}
+
+type NewSessionOptions struct {
+	// Header sent with the websocket handshake
+	Header http.Header
+
+	// Capabilities the new session has to match
+	Capabilities CapabilitiesRequest
+
+	// WebSocket configures the connection to the remote end
+	WebSocket WebSocketOptions
+}
+
+// NewSession connects to a BiDi-only endpoint (for example ws://127.0.0.1:9222/session) and creates
+// a new session with session.new. No classic WebDriver session is involved.
+func NewSession(wsURL string, optFns ...func(o *NewSessionOptions)) (*Session, error) {
+	opts := NewSessionOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	session, err := New(wsURL, opts.Header, func(o *WebSocketOptions) {
+		*o = opts.WebSocket
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := session.client.Call(context.Background(), "session.new", map[string]interface{}{
+		"capabilities": opts.Capabilities,
+	})
+	if err != nil {
+		_ = session.Close()
+		return nil, err
+	}
+
+	result := struct {
+		SessionID    string               `json:"sessionId"`
+		Capabilities *SessionCapabilities `json:"capabilities"`
+	}{}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		_ = session.Close()
+		return nil, err
+	}
+
+	session.ID = result.SessionID
+	session.Capabilities = result.Capabilities
+
+	return session, nil
+}
+
+// End ends the session with session.end and closes the connection.
+func (s *Session) End() error {
+	_, err := s.client.Call(context.Background(), "session.end", map[string]interface{}{})
+
+	// The remote end may already have closed the connection after ending the session.
+	_ = s.Close()
+
+	return err
+}
+
+// Close closes the connection to the remote end without ending the session.
+func (s *Session) Close() error {
+	return s.client.Close()
+}
+
 type Status struct {
 	Ready   bool   `json:"ready"`
 	Message string `json:"message"`
@@ -40,10 +143,8 @@ func (s *Session) Status() (*Status, error) {
 	return status, err
 }
 
-func (s *Session) Subscribe(events []string) error {
-	_, err := s.client.Call(context.Background(), "session.subscribe", map[string]interface{}{
-		"events": events,
-	})
+func (s *Session) Subscribe(events []string, optFns ...func(o *SubscribeOptions)) error {
+	_, err := s.NewSubscription(events, optFns...)
 
 	return err
 }
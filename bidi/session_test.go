@@ -0,0 +1,85 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSession(t *testing.T) {
+	wsURL, remote, header := newTestServer(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "session.new" {
+			return json.RawMessage(`{"sessionId":"s1","capabilities":{"acceptInsecureCerts":true,"browserName":"firefox","browserVersion":"120.0","platformName":"linux","setWindowRect":true,"userAgent":"gopher"}}`), nil
+		}
+
+		return nil, nil
+	})
+
+	session, err := NewSession(wsURL, func(o *NewSessionOptions) {
+		o.Header = http.Header{"X-Test": []string{"gopher"}}
+		o.Capabilities = CapabilitiesRequest{
+			AlwaysMatch: map[string]interface{}{"browserName": "firefox"},
+			FirstMatch:  []map[string]interface{}{{"platformName": "linux"}},
+		}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "gopher", (<-header).Get("X-Test"))
+
+	assert.Equal(t, "s1", session.ID)
+	assert.Equal(t, &SessionCapabilities{
+		AcceptInsecureCerts: true,
+		BrowserName:         "firefox",
+		BrowserVersion:      "120.0",
+		PlatformName:        "linux",
+		SetWindowRect:       true,
+		UserAgent:           "gopher",
+	}, session.Capabilities)
+
+	assert.Equal(t, []string{
+		`{"capabilities":{"alwaysMatch":{"browserName":"firefox"},"firstMatch":[{"platformName":"linux"}]}}`,
+	}, remote.params("session.new"))
+
+	assert.NoError(t, session.End())
+	assert.Equal(t, []string{`{}`}, remote.params("session.end"))
+
+	// The connection is closed after the session has ended
+	_, err = session.Status()
+	assert.Error(t, err)
+}
+
+func TestNewSessionError(t *testing.T) {
+	wsURL, _, _ := newTestServer(t, func(method string, params json.RawMessage) (interface{}, error) {
+		return nil, assert.AnError
+	})
+
+	_, err := NewSession(wsURL)
+	assert.Error(t, err)
+}
+
+func TestNewWithTransport(t *testing.T) {
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "session.status" {
+			return map[string]interface{}{"ready": true, "message": "ok"}, nil
+		}
+
+		return nil, nil
+	})
+
+	status, err := session.Status()
+	assert.NoError(t, err)
+	assert.Equal(t, &Status{Ready: true, Message: "ok"}, status)
+
+	assert.NoError(t, session.End())
+	assert.Equal(t, []string{`{}`}, remote.params("session.end"))
+
+	// End closes the transport
+	_, err = remote.transport.Read(context.Background())
+	assert.ErrorIs(t, err, io.ErrClosedPipe)
+
+	_, err = session.Status()
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type SubscribeOptions struct {
+	// Browsing contexts the subscription is restricted to. Events of their child frames are
+	// included. If empty, the subscription applies to all contexts.
+	Contexts []string
+
+	// User contexts the subscription is restricted to
+	UserContexts []string
+}
+
+// Subscription is a subscription to events created with session.subscribe.
+type Subscription struct {
+	ID     string   `json:"subscription"`
+	Events []string `json:"-"`
+	client *Client  `json:"-"`
+}
+
+// NewSubscription subscribes to the events and returns the subscription, which can be removed
+// independently of other subscriptions to the same events.
+func (s *Session) NewSubscription(events []string, optFns ...func(o *SubscribeOptions)) (*Subscription, error) {
+	return subscribe(s.client, events, optFns...)
+}
+
+// Unsubscribe removes the subscription. Remote ends implementing earlier drafts of the
+// specification return no subscription ID, so their subscriptions cannot be removed individually
+// and are kept.
+func (s *Subscription) Unsubscribe() error {
+	if s.ID == "" {
+		return nil
+	}
+
+	_, err := s.client.Call(context.Background(), "session.unsubscribe", map[string]interface{}{
+		"subscriptions": []string{s.ID},
+	})
+
+	return err
+}
+
+func subscribe(client *Client, events []string, optFns ...func(o *SubscribeOptions)) (*Subscription, error) {
+	opts := SubscribeOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	params := map[string]interface{}{
+		"events": events,
+	}
+
+	if len(opts.Contexts) > 0 {
+		params["contexts"] = opts.Contexts
+	}
+
+	if len(opts.UserContexts) > 0 {
+		params["userContexts"] = opts.UserContexts
+	}
+
+	data, err := client.Call(context.Background(), "session.subscribe", params)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &Subscription{Events: events}
+
+	// Remote ends implementing earlier drafts of the specification return an empty result.
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, subscription); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription.client = client
+
+	return subscription, nil
+}
+
+// eventContext returns the browsing context an event belongs to, or an empty string for events
+// that are not bound to a context.
+func eventContext(params json.RawMessage) string {
+	e := struct {
+		Context string `json:"context"`
+		Source  struct {
+			Context string `json:"context"`
+		} `json:"source"`
+	}{}
+
+	if err := json.Unmarshal(params, &e); err != nil {
+		return ""
+	}
+
+	if e.Context != "" {
+		return e.Context
+	}
+
+	return e.Source.Context
+}
@@ -0,0 +1,97 @@
+package bidi
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrowsingContextEventRouting(t *testing.T) {
+	trees := map[string]string{
+		`{"root":"tab1"}`: `{"contexts":[{"context":"tab1","children":[{"context":"frame1","children":[{"context":"frame2","children":[]}]}]}]}`,
+		`{"root":"tab2"}`: `{"contexts":[{"context":"tab2","children":[]}]}`,
+	}
+
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "browsingContext.getTree" {
+			return json.RawMessage(trees[string(params)]), nil
+		}
+
+		return nil, nil
+	})
+
+	var (
+		mu          sync.Mutex
+		loads, logs []string
+	)
+
+	record := func(events *[]string, event string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		*events = append(*events, event)
+	}
+
+	tab1 := &BrowsingContext{ID: "tab1", client: session.client}
+	tab1.OnLoad(func(event *NavigationInfo) error {
+		record(&loads, "tab1 "+event.URL)
+		return nil
+	})
+	tab1.OnLogEntryAdded(&OnLogEntryHandler{
+		LogTypeConsoleHandlerFunc: func(entry *ConsoleLogEntry) error {
+			record(&logs, entry.Source.Context+" "+entry.Text)
+			return nil
+		},
+	})
+
+	tab2 := &BrowsingContext{ID: "tab2", client: session.client}
+	tab2.OnLoad(func(event *NavigationInfo) error {
+		record(&loads, "tab2 "+event.URL)
+		return nil
+	})
+
+	remote.emit("browsingContext.load", map[string]string{"context": "tab1", "url": "https://a.example"})
+	remote.emit("browsingContext.load", map[string]string{"context": "tab2", "url": "https://b.example"})
+	remote.emit("browsingContext.load", map[string]string{"context": "frame1", "url": "https://c.example"})
+	remote.emit("browsingContext.load", map[string]string{"context": "other", "url": "https://d.example"})
+	remote.emit("log.entryAdded", map[string]interface{}{"type": "console", "text": "one", "source": map[string]string{"context": "frame2"}})
+	remote.emit("log.entryAdded", map[string]interface{}{"type": "console", "text": "two", "source": map[string]string{"context": "tab2"}})
+	remote.emit("log.entryAdded", map[string]interface{}{"type": "console", "text": "three", "source": map[string]string{"context": "tab1"}})
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(logs) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Events of child frames are delivered to the tab
+	assert.ElementsMatch(t, []string{"tab1 https://a.example", "tab2 https://b.example", "tab1 https://c.example"}, loads)
+	assert.Equal(t, []string{"frame2 one", "tab1 three"}, logs)
+
+	// The tree is looked up once per unknown context
+	var tab1Trees int
+
+	for _, params := range remote.params("browsingContext.getTree") {
+		if params == `{"root":"tab1"}` {
+			tab1Trees++
+		}
+	}
+
+	assert.Equal(t, 2, tab1Trees)
+}
+
+func TestUnsubscribeWithoutID(t *testing.T) {
+	session, remote := newTestSession(t, nil)
+
+	// Remote ends implementing earlier drafts return no subscription ID
+	subscription, err := session.NewSubscription([]string{"log.entryAdded"})
+	assert.NoError(t, err)
+	assert.Empty(t, subscription.ID)
+
+	assert.NoError(t, subscription.Unsubscribe())
+	assert.Empty(t, remote.params("session.unsubscribe"))
+}
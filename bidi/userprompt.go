@@ -0,0 +1,249 @@
+package bidi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+type UserPromptType string
+
+const (
+	UserPromptTypeAlert        UserPromptType = "alert"
+	UserPromptTypeBeforeUnload UserPromptType = "beforeunload"
+	UserPromptTypeConfirm      UserPromptType = "confirm"
+	UserPromptTypePrompt       UserPromptType = "prompt"
+)
+
+type UserPromptAction string
+
+const (
+	// UserPromptActionAccept accepts the prompt. Prompts receive the rule's text.
+	UserPromptActionAccept UserPromptAction = "accept"
+
+	// UserPromptActionDismiss dismisses the prompt.
+	UserPromptActionDismiss UserPromptAction = "dismiss"
+
+	// UserPromptActionFail dismisses the prompt and reports it as an UnexpectedUserPromptError.
+	UserPromptActionFail UserPromptAction = "fail"
+
+	// UserPromptActionIgnore leaves the prompt open, for example to handle it manually.
+	UserPromptActionIgnore UserPromptAction = "ignore"
+)
+
+// UserPromptRule describes how to handle a type of user prompt.
+type UserPromptRule struct {
+	Action UserPromptAction
+
+	// Text sent to prompt dialogs when accepting them
+	Text string
+}
+
+// UserPromptPolicy defines the rules applied to user prompts opened in any browsing context.
+// Prompt types without a rule are handled with the default rule.
+type UserPromptPolicy struct {
+	Alert        *UserPromptRule
+	BeforeUnload *UserPromptRule
+	Confirm      *UserPromptRule
+	Prompt       *UserPromptRule
+	Default      UserPromptRule
+}
+
+func (p *UserPromptPolicy) rule(typ UserPromptType) UserPromptRule {
+	var rule *UserPromptRule
+
+	switch typ {
+	case UserPromptTypeAlert:
+		rule = p.Alert
+	case UserPromptTypeBeforeUnload:
+		rule = p.BeforeUnload
+	case UserPromptTypeConfirm:
+		rule = p.Confirm
+	case UserPromptTypePrompt:
+		rule = p.Prompt
+	}
+
+	if rule != nil {
+		return *rule
+	}
+
+	return p.Default
+}
+
+// UserPrompt records a user prompt and how it was handled.
+type UserPrompt struct {
+	Context      string         `json:"context"`
+	Type         UserPromptType `json:"type"`
+	Message      string         `json:"message"`
+	DefaultValue string         `json:"defaultValue"`
+
+	// Action applied by the policy
+	Action UserPromptAction `json:"-"`
+
+	// Err is set if the prompt could not be handled
+	Err error `json:"-"`
+}
+
+// UnexpectedUserPromptError is reported for prompts handled with UserPromptActionFail.
+type UnexpectedUserPromptError struct {
+	Prompt UserPrompt
+}
+
+func (e *UnexpectedUserPromptError) Error() string {
+	return fmt.Sprintf("unexpected %s prompt in context %s: %s", e.Prompt.Type, e.Prompt.Context, e.Prompt.Message)
+}
+
+type userPromptHandler struct {
+	mu      sync.Mutex
+	policy  UserPromptPolicy
+	prompts []UserPrompt
+	errs    []error
+
+	// remove and subscription are set while the policy is active
+	remove       func()
+	subscription *Subscription
+}
+
+// SetUserPromptPolicy subscribes to browsingContext.userPromptOpened and handles every opened
+// prompt according to the policy. Calling it again replaces the policy.
+func (s *Session) SetUserPromptPolicy(policy UserPromptPolicy) error {
+	s.promptsMu.Lock()
+	defer s.promptsMu.Unlock()
+
+	if s.prompts == nil {
+		s.prompts = &userPromptHandler{}
+	}
+
+	h := s.prompts
+
+	h.mu.Lock()
+	h.policy = policy
+	h.mu.Unlock()
+
+	if h.remove != nil {
+		return nil
+	}
+
+	remove := s.client.AddEventListener("browsingContext.userPromptOpened", func(params json.RawMessage) error {
+		return h.handle(s.client, params)
+	})
+
+	subscription, err := s.NewSubscription([]string{"browsingContext.userPromptOpened"})
+	if err != nil {
+		remove()
+		return err
+	}
+
+	h.remove = remove
+	h.subscription = subscription
+
+	return nil
+}
+
+// ClearUserPromptPolicy stops handling user prompts automatically. The prompts handled so far are
+// still returned by UserPrompts.
+func (s *Session) ClearUserPromptPolicy() error {
+	s.promptsMu.Lock()
+	defer s.promptsMu.Unlock()
+
+	h := s.prompts
+	if h == nil || h.remove == nil {
+		return nil
+	}
+
+	h.remove()
+	subscription := h.subscription
+
+	h.remove = nil
+	h.subscription = nil
+
+	return subscription.Unsubscribe()
+}
+
+// UserPrompts returns the prompts handled by the policy in the order they were opened.
+func (s *Session) UserPrompts() []UserPrompt {
+	h := s.userPromptHandler()
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	prompts := make([]UserPrompt, len(h.prompts))
+	copy(prompts, h.prompts)
+
+	return prompts
+}
+
+// UserPromptError returns the first error reported by the policy since the last call, either an
+// UnexpectedUserPromptError or an error handling a prompt, and resets the reported errors.
+func (s *Session) UserPromptError() error {
+	h := s.userPromptHandler()
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.errs) == 0 {
+		return nil
+	}
+
+	err := h.errs[0]
+	h.errs = nil
+
+	return err
+}
+
+func (s *Session) userPromptHandler() *userPromptHandler {
+	s.promptsMu.Lock()
+	defer s.promptsMu.Unlock()
+
+	return s.prompts
+}
+
+func (h *userPromptHandler) handle(client *Client, params json.RawMessage) error {
+	prompt := UserPrompt{}
+	if err := json.Unmarshal(params, &prompt); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	rule := h.policy.rule(prompt.Type)
+	prompt.Action = rule.Action
+	index := len(h.prompts)
+	h.prompts = append(h.prompts, prompt)
+
+	if rule.Action == UserPromptActionFail {
+		h.errs = append(h.errs, &UnexpectedUserPromptError{Prompt: prompt})
+	}
+	h.mu.Unlock()
+
+	if rule.Action == "" || rule.Action == UserPromptActionIgnore {
+		return nil
+	}
+
+	// Handling the prompt waits for a response, so it must not block the event loop.
+	go func() {
+		params := map[string]interface{}{
+			"context": prompt.Context,
+			"accept":  rule.Action == UserPromptActionAccept,
+		}
+
+		if rule.Action == UserPromptActionAccept && prompt.Type == UserPromptTypePrompt {
+			params["userText"] = rule.Text
+		}
+
+		if _, err := client.Call(context.Background(), "browsingContext.handleUserPrompt", params); err != nil {
+			h.mu.Lock()
+			h.prompts[index].Err = err
+			h.errs = append(h.errs, err)
+			h.mu.Unlock()
+		}
+	}()
+
+	return nil
+}
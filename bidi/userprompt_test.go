@@ -0,0 +1,76 @@
+package bidi
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserPromptPolicy(t *testing.T) {
+	subscribe := subscriptionResult()
+
+	session, remote := newTestSession(t, func(method string, params json.RawMessage) (interface{}, error) {
+		if method == "browsingContext.handleUserPrompt" && eventContext(params) == "closed" {
+			return nil, errors.New("no such frame")
+		}
+
+		return subscribe(method, params)
+	})
+
+	assert.NoError(t, session.SetUserPromptPolicy(UserPromptPolicy{
+		Prompt:  &UserPromptRule{Action: UserPromptActionAccept, Text: "gopher"},
+		Confirm: &UserPromptRule{Action: UserPromptActionIgnore},
+		Default: UserPromptRule{Action: UserPromptActionFail},
+	}))
+
+	remote.emit("browsingContext.userPromptOpened", UserPrompt{Context: "tab1", Type: UserPromptTypePrompt, Message: "name?"})
+	remote.emit("browsingContext.userPromptOpened", UserPrompt{Context: "tab1", Type: UserPromptTypeConfirm, Message: "sure?"})
+	remote.emit("browsingContext.userPromptOpened", UserPrompt{Context: "tab1", Type: UserPromptTypeAlert, Message: "boom"})
+
+	assert.Eventually(t, func() bool {
+		return len(remote.params("browsingContext.handleUserPrompt")) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Prompts are handled concurrently
+	assert.ElementsMatch(t, []string{
+		`{"accept":true,"context":"tab1","userText":"gopher"}`,
+		`{"accept":false,"context":"tab1"}`,
+	}, remote.params("browsingContext.handleUserPrompt"))
+
+	prompts := session.UserPrompts()
+	assert.Len(t, prompts, 3)
+	assert.Equal(t, UserPromptActionAccept, prompts[0].Action)
+	assert.Equal(t, UserPromptActionIgnore, prompts[1].Action)
+	assert.Equal(t, UserPromptActionFail, prompts[2].Action)
+
+	var unexpected *UnexpectedUserPromptError
+	if assert.ErrorAs(t, session.UserPromptError(), &unexpected) {
+		assert.Equal(t, "boom", unexpected.Prompt.Message)
+	}
+
+	assert.NoError(t, session.UserPromptError())
+
+	// Errors handling a prompt are recorded as well
+	remote.emit("browsingContext.userPromptOpened", UserPrompt{Context: "closed", Type: UserPromptTypePrompt})
+
+	assert.Eventually(t, func() bool {
+		return session.UserPromptError() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.ErrorContains(t, session.UserPrompts()[3].Err, "no such frame")
+
+	// Clearing the policy unsubscribes, but keeps the recorded prompts
+	assert.NoError(t, session.ClearUserPromptPolicy())
+	assert.Equal(t, []string{`{"subscriptions":["sub-1"]}`}, remote.params("session.unsubscribe"))
+
+	remote.emit("browsingContext.userPromptOpened", UserPrompt{Context: "tab1", Type: UserPromptTypeAlert})
+	assert.Never(t, func() bool {
+		return len(session.UserPrompts()) != 4
+	}, 100*time.Millisecond, 10*time.Millisecond)
+
+	assert.NoError(t, session.SetUserPromptPolicy(UserPromptPolicy{}))
+	assert.Len(t, remote.params("session.subscribe"), 2)
+	assert.Len(t, session.UserPrompts(), 4)
+}
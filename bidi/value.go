@@ -0,0 +1,565 @@
+package bidi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// The BiDi value model maps to Go values as follows:
+//
+//	undefined            Undefined
+//	null                 nil
+//	string               string
+//	number               float64 (NaN, -0 and ±Infinity included); any Go integer or float when serializing
+//	boolean              bool
+//	bigint               *big.Int
+//	date                 time.Time
+//	regexp               RegExp
+//	array                []interface{}; any Go slice or array when serializing
+//	object               map[string]interface{}; any Go map with string keys or struct when serializing
+//	map                  Map
+//	set                  Set
+//	node                 *Node
+//	window               *WindowProxy
+//	other remote objects *RemoteReference
+
+// Undefined is the JavaScript undefined value.
+type Undefined struct{}
+
+// RegExp is a JavaScript regular expression.
+type RegExp struct {
+	Pattern string `json:"pattern"`
+	Flags   string `json:"flags,omitempty"`
+}
+
+// MapEntry is an entry of a JavaScript Map.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Map is a JavaScript Map. Entries keep their insertion order and keys of any type.
+type Map []MapEntry
+
+// Set is a JavaScript Set.
+type Set []interface{}
+
+type NodeProperties struct {
+	NodeType       int               `json:"nodeType"`
+	ChildNodeCount int               `json:"childNodeCount"`
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	Children       []*RemoteValue    `json:"children,omitempty"`
+	LocalName      string            `json:"localName,omitempty"`
+	Mode           string            `json:"mode,omitempty"`
+	NamespaceURI   string            `json:"namespaceURI,omitempty"`
+	NodeValue      string            `json:"nodeValue,omitempty"`
+	ShadowRoot     *RemoteValue      `json:"shadowRoot,omitempty"`
+}
+
+// Node is a DOM node. It is passed back to scripts by its shared id.
+type Node struct {
+	SharedID   string
+	Handle     string
+	Properties *NodeProperties
+}
+
+// WindowProxy is a reference to the window of a browsing context. It can only be passed back to
+// scripts if it has a handle.
+type WindowProxy struct {
+	Context string
+	Handle  string
+}
+
+// RemoteReference is an object that has no Go representation, such as a function or a promise,
+// or a collection whose contents exceeded the serialization depth. It is passed back to scripts by
+// its handle.
+type RemoteReference struct {
+	Type       string
+	Handle     string
+	InternalID string
+}
+
+// RemoteValue is a value serialized by the browser.
+type RemoteValue struct {
+	Type       string          `json:"type"`
+	Handle     string          `json:"handle,omitempty"`
+	InternalID string          `json:"internalId,omitempty"`
+	SharedID   string          `json:"sharedId,omitempty"`
+	Value      json.RawMessage `json:"value,omitempty"`
+}
+
+// Interface returns the Go representation of the value.
+func (v *RemoteValue) Interface() (interface{}, error) {
+	switch v.Type {
+	case "undefined":
+		return Undefined{}, nil
+	case "null":
+		return nil, nil
+	case "string":
+		var s string
+		err := json.Unmarshal(v.Value, &s)
+
+		return s, err
+	case "number":
+		return decodeNumber(v.Value)
+	case "boolean":
+		var b bool
+		err := json.Unmarshal(v.Value, &b)
+
+		return b, err
+	case "bigint":
+		var s string
+		if err := json.Unmarshal(v.Value, &s); err != nil {
+			return nil, err
+		}
+
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid bigint: %s", s)
+		}
+
+		return i, nil
+	case "date":
+		var s string
+		if err := json.Unmarshal(v.Value, &s); err != nil {
+			return nil, err
+		}
+
+		return time.Parse(time.RFC3339Nano, s)
+	case "regexp":
+		r := RegExp{}
+		err := json.Unmarshal(v.Value, &r)
+
+		return r, err
+	case "node":
+		n := &Node{SharedID: v.SharedID, Handle: v.Handle}
+		if v.Value != nil {
+			n.Properties = &NodeProperties{}
+			if err := json.Unmarshal(v.Value, n.Properties); err != nil {
+				return nil, err
+			}
+		}
+
+		return n, nil
+	case "window":
+		w := struct {
+			Context string `json:"context"`
+		}{}
+
+		if err := json.Unmarshal(v.Value, &w); err != nil {
+			return nil, err
+		}
+
+		return &WindowProxy{Context: w.Context, Handle: v.Handle}, nil
+	}
+
+	if v.Value == nil {
+		return v.reference(), nil
+	}
+
+	switch v.Type {
+	case "array":
+		return decodeList(v.Value)
+	case "set":
+		list, err := decodeList(v.Value)
+		return Set(list), err
+	case "object":
+		entries, err := decodeEntries(v.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		obj := make(map[string]interface{}, len(entries))
+
+		for _, e := range entries {
+			key, ok := e.Key.(string)
+			if !ok {
+				// Symbol keys have no representation in a Go map.
+				return entries, nil
+			}
+
+			obj[key] = e.Value
+		}
+
+		return obj, nil
+	case "map":
+		return decodeEntries(v.Value)
+	}
+
+	return v.reference(), nil
+}
+
+func (v *RemoteValue) reference() *RemoteReference {
+	return &RemoteReference{Type: v.Type, Handle: v.Handle, InternalID: v.InternalID}
+}
+
+// UnmarshalRemoteValue decodes a serialized remote value into its Go representation.
+func UnmarshalRemoteValue(data []byte) (interface{}, error) {
+	v := &RemoteValue{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v.Interface()
+}
+
+func decodeNumber(data json.RawMessage) (float64, error) {
+	var special string
+	if err := json.Unmarshal(data, &special); err == nil {
+		switch special {
+		case "NaN":
+			return math.NaN(), nil
+		case "-0":
+			return math.Copysign(0, -1), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+
+		return 0, fmt.Errorf("invalid number: %s", special)
+	}
+
+	var f float64
+	err := json.Unmarshal(data, &f)
+
+	return f, err
+}
+
+func decodeList(data json.RawMessage) ([]interface{}, error) {
+	var items []*RemoteValue
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+
+	list := make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		v, err := item.Interface()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, v)
+	}
+
+	return list, nil
+}
+
+func decodeEntries(data json.RawMessage) (Map, error) {
+	var pairs [][2]json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return nil, err
+	}
+
+	entries := make(Map, 0, len(pairs))
+
+	for _, pair := range pairs {
+		var (
+			key interface{}
+			s   string
+		)
+
+		// Keys are either plain strings or serialized values.
+		if err := json.Unmarshal(pair[0], &s); err == nil {
+			key = s
+		} else {
+			k, err := UnmarshalRemoteValue(pair[0])
+			if err != nil {
+				return nil, err
+			}
+
+			key = k
+		}
+
+		value, err := UnmarshalRemoteValue(pair[1])
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, MapEntry{Key: key, Value: value})
+	}
+
+	return entries, nil
+}
+
+// LocalValue wraps a Go value so that it is serialized as a BiDi local value, for example as a
+// script argument.
+type LocalValue struct {
+	value interface{}
+}
+
+func NewLocalValue(v interface{}) LocalValue {
+	return LocalValue{value: v}
+}
+
+func (l LocalValue) MarshalJSON() ([]byte, error) {
+	return MarshalLocalValue(l.value)
+}
+
+// MarshalLocalValue serializes a Go value as a BiDi local value. A json.RawMessage is assumed to
+// be serialized already and is passed through unchanged.
+func MarshalLocalValue(v interface{}) ([]byte, error) {
+	local, err := localValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(local)
+}
+
+type typedValue struct {
+	Type  string      `json:"type"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type reference struct {
+	Handle   string `json:"handle,omitempty"`
+	SharedID string `json:"sharedId,omitempty"`
+}
+
+func localValue(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case nil:
+		return typedValue{Type: "null"}, nil
+	case Undefined, *Undefined:
+		return typedValue{Type: "undefined"}, nil
+	case LocalValue:
+		return localValue(t.value)
+	case json.RawMessage:
+		return t, nil
+	case ChannelValue:
+		return t, nil
+	case string:
+		return typedValue{Type: "string", Value: t}, nil
+	case bool:
+		return typedValue{Type: "boolean", Value: t}, nil
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return nil, err
+		}
+
+		return localNumber(f), nil
+	case *big.Int:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		return typedValue{Type: "bigint", Value: t.String()}, nil
+	case big.Int:
+		return typedValue{Type: "bigint", Value: t.String()}, nil
+	case time.Time:
+		return typedValue{Type: "date", Value: t.UTC().Format("2006-01-02T15:04:05.000Z")}, nil
+	case RegExp:
+		return typedValue{Type: "regexp", Value: t}, nil
+	case *RegExp:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		return localValue(*t)
+	case Map:
+		entries := make([][2]interface{}, 0, len(t))
+
+		for _, e := range t {
+			key, err := localValue(e.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := localValue(e.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, [2]interface{}{key, value})
+		}
+
+		return typedValue{Type: "map", Value: entries}, nil
+	case Set:
+		list, err := localList(reflect.ValueOf(t))
+		if err != nil {
+			return nil, err
+		}
+
+		return typedValue{Type: "set", Value: list}, nil
+	case *Node:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		if t.SharedID == "" && t.Handle == "" {
+			return nil, errors.New("node without shared id or handle")
+		}
+
+		return reference{SharedID: t.SharedID, Handle: t.Handle}, nil
+	case *WindowProxy:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		if t.Handle == "" {
+			return nil, fmt.Errorf("window of context %s without handle", t.Context)
+		}
+
+		return reference{Handle: t.Handle}, nil
+	case *RemoteReference:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		if t.Handle == "" {
+			return nil, fmt.Errorf("%s without handle", t.Type)
+		}
+
+		return reference{Handle: t.Handle}, nil
+	case *RemoteValue:
+		if t == nil {
+			return typedValue{Type: "null"}, nil
+		}
+
+		if t.SharedID != "" || t.Handle != "" {
+			return reference{SharedID: t.SharedID, Handle: t.Handle}, nil
+		}
+
+		value, err := t.Interface()
+		if err != nil {
+			return nil, err
+		}
+
+		return localValue(value)
+	}
+
+	return localReflectValue(reflect.ValueOf(v))
+}
+
+func localReflectValue(rv reflect.Value) (interface{}, error) {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return typedValue{Type: "null"}, nil
+		}
+
+		return localValue(rv.Elem().Interface())
+	case reflect.String:
+		return typedValue{Type: "string", Value: rv.String()}, nil
+	case reflect.Bool:
+		return typedValue{Type: "boolean", Value: rv.Bool()}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return typedValue{Type: "number", Value: rv.Int()}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return typedValue{Type: "number", Value: rv.Uint()}, nil
+	case reflect.Float32, reflect.Float64:
+		return localNumber(rv.Float()), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return typedValue{Type: "null"}, nil
+		}
+
+		list, err := localList(rv)
+		if err != nil {
+			return nil, err
+		}
+
+		return typedValue{Type: "array", Value: list}, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return typedValue{Type: "null"}, nil
+		}
+
+		return localMap(rv)
+	case reflect.Struct:
+		// Structs are serialized as objects with the fields encoding/json would produce.
+		data, err := json.Marshal(rv.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		var generic interface{}
+
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+
+		if err := d.Decode(&generic); err != nil {
+			return nil, err
+		}
+
+		return localValue(generic)
+	}
+
+	return nil, fmt.Errorf("unsupported local value type: %s", rv.Type())
+}
+
+func localNumber(f float64) interface{} {
+	switch {
+	case math.IsNaN(f):
+		return typedValue{Type: "number", Value: "NaN"}
+	case math.IsInf(f, 1):
+		return typedValue{Type: "number", Value: "Infinity"}
+	case math.IsInf(f, -1):
+		return typedValue{Type: "number", Value: "-Infinity"}
+	case f == 0 && math.Signbit(f):
+		return typedValue{Type: "number", Value: "-0"}
+	}
+
+	return typedValue{Type: "number", Value: f}
+}
+
+func localList(rv reflect.Value) ([]interface{}, error) {
+	list := make([]interface{}, 0, rv.Len())
+
+	for i := 0; i < rv.Len(); i++ {
+		item, err := localValue(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, item)
+	}
+
+	return list, nil
+}
+
+func localMap(rv reflect.Value) (interface{}, error) {
+	keys := rv.MapKeys()
+
+	// Maps with string keys are objects. Keys are sorted to keep the serialization stable.
+	if rv.Type().Key().Kind() == reflect.String {
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		entries := make([][2]interface{}, 0, len(keys))
+
+		for _, k := range keys {
+			value, err := localValue(rv.MapIndex(k).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, [2]interface{}{k.String(), value})
+		}
+
+		return typedValue{Type: "object", Value: entries}, nil
+	}
+
+	entries := make(Map, 0, len(keys))
+
+	for _, k := range keys {
+		entries = append(entries, MapEntry{Key: k.Interface(), Value: rv.MapIndex(k).Interface()})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return fmt.Sprint(entries[i].Key) < fmt.Sprint(entries[j].Key)
+	})
+
+	return localValue(entries)
+}
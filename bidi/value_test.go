@@ -0,0 +1,96 @@
+package bidi
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalLocalValue(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC)
+
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{nil, `{"type":"null"}`},
+		{Undefined{}, `{"type":"undefined"}`},
+		{"go", `{"type":"string","value":"go"}`},
+		{"", `{"type":"string","value":""}`},
+		{false, `{"type":"boolean","value":false}`},
+		{0, `{"type":"number","value":0}`},
+		{1.5, `{"type":"number","value":1.5}`},
+		{math.NaN(), `{"type":"number","value":"NaN"}`},
+		{math.Copysign(0, -1), `{"type":"number","value":"-0"}`},
+		{math.Inf(-1), `{"type":"number","value":"-Infinity"}`},
+		{big.NewInt(42), `{"type":"bigint","value":"42"}`},
+		{date, `{"type":"date","value":"2024-01-02T03:04:05.006Z"}`},
+		{RegExp{Pattern: "a+", Flags: "g"}, `{"type":"regexp","value":{"pattern":"a+","flags":"g"}}`},
+		{[]int{1, 2}, `{"type":"array","value":[{"type":"number","value":1},{"type":"number","value":2}]}`},
+		{Set{"a"}, `{"type":"set","value":[{"type":"string","value":"a"}]}`},
+		{map[string]bool{"b": true, "a": false}, `{"type":"object","value":[["a",{"type":"boolean","value":false}],["b",{"type":"boolean","value":true}]]}`},
+		{Map{{Key: 1, Value: "one"}}, `{"type":"map","value":[[{"type":"number","value":1},{"type":"string","value":"one"}]]}`},
+		{struct {
+			Name string `json:"name"`
+		}{"go"}, `{"type":"object","value":[["name",{"type":"string","value":"go"}]]}`},
+		{&Node{SharedID: "n1"}, `{"sharedId":"n1"}`},
+		{&RemoteReference{Type: "function", Handle: "h1"}, `{"handle":"h1"}`},
+		{(*RegExp)(nil), `{"type":"null"}`},
+		{(*Node)(nil), `{"type":"null"}`},
+		{(*WindowProxy)(nil), `{"type":"null"}`},
+		{(*RemoteReference)(nil), `{"type":"null"}`},
+		{(*RemoteValue)(nil), `{"type":"null"}`},
+		{[]interface{}{(*Node)(nil)}, `{"type":"array","value":[{"type":"null"}]}`},
+	}
+
+	for _, tt := range tests {
+		data, err := MarshalLocalValue(tt.value)
+		assert.NoError(t, err)
+		assert.JSONEq(t, tt.expected, string(data))
+	}
+
+	_, err := MarshalLocalValue(&WindowProxy{Context: "ctx"})
+	assert.Error(t, err)
+}
+
+func TestUnmarshalRemoteValue(t *testing.T) {
+	v, err := UnmarshalRemoteValue([]byte(`{"type":"number","value":"-0"}`))
+	assert.NoError(t, err)
+	assert.True(t, math.Signbit(v.(float64)))
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"bigint","value":"12345678901234567890"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567890", v.(*big.Int).String())
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"date","value":"2024-01-02T03:04:05.006Z"}`))
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC).Equal(v.(time.Time)))
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"object","value":[
+		["list",{"type":"array","value":[{"type":"undefined"},{"type":"null"},{"type":"boolean","value":true}]}],
+		["set",{"type":"set","value":[{"type":"string","value":"a"}]}],
+		["map",{"type":"map","value":[[{"type":"number","value":1},{"type":"regexp","value":{"pattern":"x"}}]]}]
+	]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"list": []interface{}{Undefined{}, nil, true},
+		"set":  Set{"a"},
+		"map":  Map{{Key: 1.0, Value: RegExp{Pattern: "x"}}},
+	}, v)
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"node","sharedId":"n1","value":{"nodeType":1,"childNodeCount":0,"localName":"div"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", v.(*Node).SharedID)
+	assert.Equal(t, "div", v.(*Node).Properties.LocalName)
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"window","value":{"context":"ctx"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &WindowProxy{Context: "ctx"}, v)
+
+	v, err = UnmarshalRemoteValue([]byte(`{"type":"promise","handle":"h1"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &RemoteReference{Type: "promise", Handle: "h1"}, v)
+}
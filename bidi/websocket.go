@@ -2,14 +2,79 @@ package bidi
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
+	"time"
 
 	"nhooyr.io/websocket"
 )
 
+// DefaultReadLimit is the maximum message size used if no read limit is configured. It is large
+// enough for screenshots and big script results.
+const DefaultReadLimit = 64 << 20
+
+// CompressionMode controls the permessage-deflate extension.
+type CompressionMode int
+
+const (
+	// CompressionNoContextTakeover compresses every message on its own. Messages smaller than the
+	// compression threshold are not compressed.
+	CompressionNoContextTakeover CompressionMode = iota
+
+	// CompressionContextTakeover reuses the compression window across messages, which compresses
+	// repetitive messages better at the cost of memory per connection.
+	CompressionContextTakeover
+
+	// CompressionDisabled disables the extension.
+	CompressionDisabled
+)
+
+// websocketMode maps the mode to the websocket library, independent of the order of its constants.
+func (m CompressionMode) websocketMode() websocket.CompressionMode {
+	switch m {
+	case CompressionContextTakeover:
+		return websocket.CompressionContextTakeover
+	case CompressionDisabled:
+		return websocket.CompressionDisabled
+	default:
+		return websocket.CompressionNoContextTakeover
+	}
+}
+
+type WebSocketOptions struct {
+	// ReadLimit is the maximum size of a message in bytes. Defaults to DefaultReadLimit.
+	ReadLimit int64
+
+	// Compression controls permessage-deflate compression. Defaults to CompressionNoContextTakeover.
+	Compression CompressionMode
+
+	// CompressionThreshold is the minimum size of a message before it is compressed
+	CompressionThreshold int
+
+	// DialTimeout limits the time to establish the connection. Zero means no timeout.
+	DialTimeout time.Duration
+
+	// HTTPClient used for the handshake
+	HTTPClient *http.Client
+
+	// TLSConfig used for wss connections. Ignored if HTTPClient is set.
+	TLSConfig *tls.Config
+}
+
 type WebSocket struct {
 	conn *websocket.Conn
+	opts WebSocketOptions
+}
+
+func NewWebSocket(optFns ...func(o *WebSocketOptions)) *WebSocket {
+	opts := WebSocketOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	return &WebSocket{opts: opts}
 }
 
 func (ws *WebSocket) Connect(ctx context.Context, wsURL string, header http.Header) error {
@@ -17,13 +82,38 @@ func (ws *WebSocket) Connect(ctx context.Context, wsURL string, header http.Head
 		return fmt.Errorf("duplicated connection: %s", wsURL)
 	}
 
+	if ws.opts.DialTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, ws.opts.DialTimeout)
+		defer cancel()
+	}
+
+	httpClient := ws.opts.HTTPClient
+	if httpClient == nil && ws.opts.TLSConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = ws.opts.TLSConfig
+
+		httpClient = &http.Client{Transport: transport}
+	}
+
 	c, _, err := websocket.Dial(ctx, wsURL, &websocket.DialOptions{ //nolint bodyclose
-		HTTPHeader: header,
+		HTTPClient:           httpClient,
+		HTTPHeader:           header,
+		CompressionMode:      ws.opts.Compression.websocketMode(),
+		CompressionThreshold: ws.opts.CompressionThreshold,
 	})
 	if err != nil {
 		return err
 	}
 
+	readLimit := ws.opts.ReadLimit
+	if readLimit <= 0 {
+		readLimit = DefaultReadLimit
+	}
+
+	c.SetReadLimit(readLimit)
+
 	ws.conn = c
 
 	return nil
@@ -37,15 +127,13 @@ func (ws *WebSocket) Write(ctx context.Context, p []byte) error {
 	return ws.conn.Write(ctx, websocket.MessageText, p)
 }
 
+// Read reads the next message. Binary messages are returned like text messages, as some remote
+// ends send their JSON payloads in binary frames.
 func (ws *WebSocket) Read(ctx context.Context) ([]byte, error) {
-	typ, p, err := ws.conn.Read(ctx)
+	_, p, err := ws.conn.Read(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if typ != websocket.MessageText {
-		return nil, fmt.Errorf("unsupported messageType: %s", typ)
-	}
-
 	return p, nil
 }
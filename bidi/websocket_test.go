@@ -0,0 +1,53 @@
+package bidi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"nhooyr.io/websocket"
+)
+
+func TestWebSocketRead(t *testing.T) {
+	payload := []byte(`{"id":1,"result":"` + strings.Repeat("a", 1<<20) + `"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		defer c.Close(websocket.StatusNormalClosure, "")
+
+		_ = c.Write(r.Context(), websocket.MessageBinary, payload)
+		_, _, _ = c.Read(r.Context())
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	ws := NewWebSocket()
+	assert.NoError(t, ws.Connect(context.Background(), wsURL, nil))
+
+	data, err := ws.Read(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, payload, data)
+	assert.NoError(t, ws.Close())
+
+	ws = NewWebSocket(func(o *WebSocketOptions) {
+		o.ReadLimit = 1024
+	})
+	assert.NoError(t, ws.Connect(context.Background(), wsURL, nil))
+
+	_, err = ws.Read(context.Background())
+	assert.Error(t, err)
+}
+
+func TestCompressionMode(t *testing.T) {
+	assert.Equal(t, websocket.CompressionNoContextTakeover, CompressionNoContextTakeover.websocketMode())
+	assert.Equal(t, websocket.CompressionContextTakeover, CompressionContextTakeover.websocketMode())
+	assert.Equal(t, websocket.CompressionDisabled, CompressionDisabled.websocketMode())
+}
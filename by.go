@@ -0,0 +1,281 @@
+package webdriver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// SearchContext is where elements are searched. It is implemented by Session, Element and
+// ShadowRoot.
+type SearchContext interface {
+	FindElement(strategy LocatorStrategy, selector string) (*Element, error)
+	FindElements(strategy LocatorStrategy, selector string) ([]Element, error)
+}
+
+// By locates elements in a search context.
+type By interface {
+	FindElements(sc SearchContext) ([]Element, error)
+}
+
+// ErrNoSuchElement is returned if a By locator does not match any element.
+var ErrNoSuchElement = errors.New("no such element")
+
+// Locator locates elements with one of the W3C locator strategies.
+type Locator struct {
+	Strategy LocatorStrategy
+	Selector string
+}
+
+func (l Locator) FindElements(sc SearchContext) ([]Element, error) {
+	return sc.FindElements(l.Strategy, l.Selector)
+}
+
+func (l Locator) String() string {
+	return fmt.Sprintf("%s=%s", l.Strategy, l.Selector)
+}
+
+// ByCSSSelector locates elements matching a CSS selector.
+func ByCSSSelector(selector string) Locator {
+	return Locator{Strategy: LocatorStrategyCSSSelector, Selector: selector}
+}
+
+// ByXPath locates elements matching an XPath expression. Shadow roots do not support XPath.
+func ByXPath(expression string) Locator {
+	return Locator{Strategy: LocatorStrategyXPath, Selector: expression}
+}
+
+// ByTagName locates elements by their tag name.
+func ByTagName(name string) Locator {
+	return Locator{Strategy: LocatorStrategyTagName, Selector: name}
+}
+
+// ByLinkText locates links by their exact visible text.
+func ByLinkText(text string) Locator {
+	return Locator{Strategy: LocatorStrategyLinkText, Selector: text}
+}
+
+// ByPartialLinkText locates links whose visible text contains text.
+func ByPartialLinkText(text string) Locator {
+	return Locator{Strategy: LocatorStrategyPartialLinkText, Selector: text}
+}
+
+// ByID locates elements by their id attribute.
+func ByID(id string) Locator {
+	return ByCSSSelector(cssAttribute("id", "=", id))
+}
+
+// ByName locates elements by their name attribute.
+func ByName(name string) Locator {
+	return ByCSSSelector(cssAttribute("name", "=", name))
+}
+
+// ByClassName locates elements that have the class name.
+func ByClassName(name string) Locator {
+	return ByCSSSelector(cssAttribute("class", "~=", name))
+}
+
+// ByTestID locates elements by their data-testid attribute.
+func ByTestID(id string) Locator {
+	return ByCSSSelector(cssAttribute("data-testid", "=", id))
+}
+
+// ByText locates elements that have a text node equal to text, ignoring leading, trailing and
+// repeated whitespace. ByText uses XPath, so it cannot search shadow roots.
+func ByText(text string) Locator {
+	return ByXPath(fmt.Sprintf(".//*[text()[normalize-space(.)=%s]]", xpathLiteral(strings.Join(strings.Fields(text), " "))))
+}
+
+// cssAttribute returns an attribute selector with a quoted value.
+func cssAttribute(name, operator, value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\a `).Replace(value)
+	return fmt.Sprintf(`[%s%s"%s"]`, name, operator, value)
+}
+
+// xpathLiteral returns s as an XPath string literal. XPath 1.0 has no escapes, so strings with both
+// quote characters are built with concat.
+func xpathLiteral(s string) string {
+	if !strings.Contains(s, `'`) {
+		return fmt.Sprintf("'%s'", s)
+	}
+
+	if !strings.Contains(s, `"`) {
+		return fmt.Sprintf(`"%s"`, s)
+	}
+
+	parts := strings.Split(s, `'`)
+	for i, part := range parts {
+		parts[i] = fmt.Sprintf("'%s'", part)
+	}
+
+	return fmt.Sprintf(`concat(%s)`, strings.Join(parts, `, "'", `))
+}
+
+func findElementBy(sc SearchContext, by By) (*Element, error) {
+	elements, err := by.FindElements(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elements) == 0 {
+		if s, ok := by.(fmt.Stringer); ok {
+			return nil, fmt.Errorf("%w: %s", ErrNoSuchElement, s)
+		}
+
+		return nil, ErrNoSuchElement
+	}
+
+	return &elements[0], nil
+}
+
+// RelativeBy filters the elements of a locator by their position relative to other elements, like
+// the relative locators of Selenium 4. The positions are taken from the element rects, so elements
+// must be rendered. Matches are sorted by their distance to the first anchor.
+type RelativeBy struct {
+	by      By
+	filters []relativeFilter
+}
+
+// Relative returns a relative locator for the elements of by.
+func Relative(by By) *RelativeBy {
+	return &RelativeBy{by: by}
+}
+
+type relativeFilter struct {
+	anchor *Element
+	match  func(rect, anchor *ElementRect) bool
+}
+
+// Above keeps elements that end above the top of anchor.
+func (r *RelativeBy) Above(anchor *Element) *RelativeBy {
+	return r.with(anchor, func(rect, anchor *ElementRect) bool {
+		return rect.Y+rect.Height <= anchor.Y
+	})
+}
+
+// Below keeps elements that start below the bottom of anchor.
+func (r *RelativeBy) Below(anchor *Element) *RelativeBy {
+	return r.with(anchor, func(rect, anchor *ElementRect) bool {
+		return rect.Y >= anchor.Y+anchor.Height
+	})
+}
+
+// LeftOf keeps elements that end left of anchor.
+func (r *RelativeBy) LeftOf(anchor *Element) *RelativeBy {
+	return r.with(anchor, func(rect, anchor *ElementRect) bool {
+		return rect.X+rect.Width <= anchor.X
+	})
+}
+
+// RightOf keeps elements that start right of anchor.
+func (r *RelativeBy) RightOf(anchor *Element) *RelativeBy {
+	return r.with(anchor, func(rect, anchor *ElementRect) bool {
+		return rect.X >= anchor.X+anchor.Width
+	})
+}
+
+// Near keeps elements whose edges are at most distance CSS pixels away from anchor. A distance of
+// zero or less uses 50 pixels.
+func (r *RelativeBy) Near(anchor *Element, distance float64) *RelativeBy {
+	if distance <= 0 {
+		distance = 50 // nolint gomnd
+	}
+
+	return r.with(anchor, func(rect, anchor *ElementRect) bool {
+		return rectDistance(rect, anchor) <= distance
+	})
+}
+
+func (r *RelativeBy) with(anchor *Element, match func(rect, anchor *ElementRect) bool) *RelativeBy {
+	r.filters = append(r.filters, relativeFilter{anchor: anchor, match: match})
+	return r
+}
+
+func (r *RelativeBy) FindElements(sc SearchContext) ([]Element, error) {
+	candidates, err := r.by.FindElements(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	anchors := make([]*ElementRect, len(r.filters))
+
+	for i, f := range r.filters {
+		if anchors[i], err = f.anchor.GetRect(); err != nil {
+			return nil, err
+		}
+	}
+
+	type match struct {
+		element  Element
+		distance float64
+	}
+
+	var matches []match
+
+	for _, candidate := range candidates {
+		if r.isAnchor(candidate) {
+			continue
+		}
+
+		rect, err := candidate.GetRect()
+		if err != nil {
+			return nil, err
+		}
+
+		if !r.match(rect, anchors) {
+			continue
+		}
+
+		m := match{element: candidate}
+		if len(anchors) > 0 {
+			m.distance = centerDistance(rect, anchors[0])
+		}
+
+		matches = append(matches, m)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	elements := make([]Element, len(matches))
+	for i, m := range matches {
+		elements[i] = m.element
+	}
+
+	return elements, nil
+}
+
+func (r *RelativeBy) isAnchor(e Element) bool {
+	for _, f := range r.filters {
+		if f.anchor.ID == e.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *RelativeBy) match(rect *ElementRect, anchors []*ElementRect) bool {
+	for i, f := range r.filters {
+		if !f.match(rect, anchors[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rectDistance returns the shortest distance between the edges of two rects.
+func rectDistance(a, b *ElementRect) float64 {
+	dx := math.Max(0, math.Max(b.X-(a.X+a.Width), a.X-(b.X+b.Width)))
+	dy := math.Max(0, math.Max(b.Y-(a.Y+a.Height), a.Y-(b.Y+b.Height)))
+
+	return math.Hypot(dx, dy)
+}
+
+func centerDistance(a, b *ElementRect) float64 {
+	return math.Hypot((a.X+a.Width/2)-(b.X+b.Width/2), (a.Y+a.Height/2)-(b.Y+b.Height/2))
+}
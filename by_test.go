@@ -0,0 +1,86 @@
+package webdriver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocators(t *testing.T) {
+	tests := []struct {
+		by       Locator
+		expected Locator
+	}{
+		{ByID("login"), Locator{LocatorStrategyCSSSelector, `[id="login"]`}},
+		{ByName(`q"1`), Locator{LocatorStrategyCSSSelector, `[name="q\"1"]`}},
+		{ByClassName("btn"), Locator{LocatorStrategyCSSSelector, `[class~="btn"]`}},
+		{ByTestID("submit"), Locator{LocatorStrategyCSSSelector, `[data-testid="submit"]`}},
+		{ByText("  Sign   in "), Locator{LocatorStrategyXPath, `.//*[text()[normalize-space(.)='Sign in']]`}},
+		{ByText(`It's "ok"`), Locator{LocatorStrategyXPath, `.//*[text()[normalize-space(.)=concat('It', "'", 's "ok"')]]`}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.by)
+	}
+
+	// Shadow roots reject XPath before sending the command
+	shadowRoot := &ShadowRoot{ID: "root", SessionID: "s1", client: NewRestClient("http://127.0.0.1:0")}
+
+	_, err := shadowRoot.FindElementBy(ByText("Sign in"))
+	assert.ErrorIs(t, err, ErrShadowRootXPath)
+
+	_, err = shadowRoot.FindElementsBy(ByXPath("//a"))
+	assert.ErrorIs(t, err, ErrShadowRootXPath)
+}
+
+func TestRelativeBy(t *testing.T) {
+	rects := map[string]ElementRect{
+		"anchor": {X: 100, Y: 100, Width: 100, Height: 20},
+		"above":  {X: 100, Y: 50, Width: 100, Height: 20},
+		"below":  {X: 100, Y: 200, Width: 100, Height: 20},
+		"left":   {X: 10, Y: 100, Width: 50, Height: 20},
+		"right":  {X: 210, Y: 100, Width: 50, Height: 20},
+	}
+
+	remote := newTestRemote(t, func(cmd *testCommand) interface{} {
+		switch {
+		case cmd.Path == "/elements":
+			var elements []map[string]string
+			for _, id := range []string{"anchor", "below", "above", "left", "right"} {
+				elements = append(elements, elementValue(id))
+			}
+
+			return elements
+		case strings.HasSuffix(cmd.Path, "/rect"):
+			return rects[strings.Split(cmd.Path, "/")[2]]
+		}
+
+		return nil
+	})
+
+	session := remote.session("s1")
+	anchor := &Element{ID: "anchor", SessionID: "s1", client: session.client}
+
+	ids := func(by By) []string {
+		elements, err := session.FindElementsBy(by)
+		assert.NoError(t, err)
+
+		var ids []string
+		for _, e := range elements {
+			ids = append(ids, e.ID)
+		}
+
+		return ids
+	}
+
+	assert.Equal(t, []string{"above"}, ids(Relative(ByTagName("input")).Above(anchor)))
+	assert.Equal(t, []string{"below"}, ids(Relative(ByTagName("input")).Below(anchor)))
+	assert.Equal(t, []string{"left"}, ids(Relative(ByTagName("input")).LeftOf(anchor)))
+	assert.Equal(t, []string{"right"}, ids(Relative(ByTagName("input")).RightOf(anchor)))
+	assert.Equal(t, []string{"above", "right", "left"}, ids(Relative(ByTagName("input")).Near(anchor, 45)))
+	assert.Empty(t, ids(Relative(ByTagName("input")).Above(anchor).Below(anchor)))
+
+	_, err := session.FindElementBy(Relative(ByTagName("input")).Above(anchor).Below(anchor))
+	assert.ErrorIs(t, err, ErrNoSuchElement)
+}
@@ -1,7 +1,21 @@
 package webdriver
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+)
+
 type Capabilities map[string]interface{}
 
+// BrowserName returns the browser name.
+func (c Capabilities) BrowserName() string {
+	return c.getString("browserName")
+}
+
 // SetBrowserName sets the desired browser name.
 func (c Capabilities) SetBrowserName(name string) Capabilities {
 	c["browserName"] = name
@@ -30,46 +44,361 @@ func (c Capabilities) SetProxy(p ProxyConfig) Capabilities {
 	return c
 }
 
+// Proxy returns the proxy configuration.
+func (c Capabilities) Proxy() *ProxyConfig {
+	proxy := &ProxyConfig{}
+	if !c.decode("proxy", proxy) {
+		return nil
+	}
+
+	return proxy
+}
+
 // SetBrowserVersion sets the desired browser version.
 func (c Capabilities) SetBrowserVersion(version string) Capabilities {
 	c["browserVersion"] = version
 	return c
 }
 
+// BrowserVersion returns the browser version.
+func (c Capabilities) BrowserVersion() string {
+	return c.getString("browserVersion")
+}
+
 // SetPlatformName sets the desired browser platform.
 func (c Capabilities) SetPlatformName(platform string) Capabilities {
 	c["platformName"] = platform
 	return c
 }
 
+// PlatformName returns the browser platform.
+func (c Capabilities) PlatformName() string {
+	return c.getString("platformName")
+}
+
 func (c Capabilities) SetAcceptInsecureCerts(acceptInsecureCerts bool) Capabilities {
 	c["acceptInsecureCerts"] = acceptInsecureCerts
 	return c
 }
 
+func (c Capabilities) AcceptInsecureCerts() bool {
+	return c.getBool("acceptInsecureCerts")
+}
+
+type PageLoadStrategy string
+
+const (
+	PageLoadStrategyNone   PageLoadStrategy = "none"
+	PageLoadStrategyEager  PageLoadStrategy = "eager"
+	PageLoadStrategyNormal PageLoadStrategy = "normal"
+)
+
+// SetPageLoadStrategy sets when navigation commands return.
+func (c Capabilities) SetPageLoadStrategy(strategy PageLoadStrategy) Capabilities {
+	c["pageLoadStrategy"] = strategy
+	return c
+}
+
+func (c Capabilities) PageLoadStrategy() PageLoadStrategy {
+	return PageLoadStrategy(c.getString("pageLoadStrategy"))
+}
+
+// SetTimeouts sets the initial timeouts of the session.
+func (c Capabilities) SetTimeouts(timeouts Timeouts) Capabilities {
+	c["timeouts"] = timeouts
+	return c
+}
+
+func (c Capabilities) Timeouts() *Timeouts {
+	timeouts := &Timeouts{}
+	if !c.decode("timeouts", timeouts) {
+		return nil
+	}
+
+	return timeouts
+}
+
+type UnhandledPromptBehavior string
+
+const (
+	UnhandledPromptBehaviorDismiss          UnhandledPromptBehavior = "dismiss"
+	UnhandledPromptBehaviorAccept           UnhandledPromptBehavior = "accept"
+	UnhandledPromptBehaviorDismissAndNotify UnhandledPromptBehavior = "dismiss and notify"
+	UnhandledPromptBehaviorAcceptAndNotify  UnhandledPromptBehavior = "accept and notify"
+	UnhandledPromptBehaviorIgnore           UnhandledPromptBehavior = "ignore"
+)
+
+// SetUnhandledPromptBehavior sets how user prompts are handled that are open when a command is run.
+func (c Capabilities) SetUnhandledPromptBehavior(behavior UnhandledPromptBehavior) Capabilities {
+	c["unhandledPromptBehavior"] = behavior
+	return c
+}
+
+func (c Capabilities) UnhandledPromptBehavior() UnhandledPromptBehavior {
+	return UnhandledPromptBehavior(c.getString("unhandledPromptBehavior"))
+}
+
+// SetStrictFileInteractability sets whether interactability checks are applied to file inputs.
+func (c Capabilities) SetStrictFileInteractability(strict bool) Capabilities {
+	c["strictFileInteractability"] = strict
+	return c
+}
+
+func (c Capabilities) StrictFileInteractability() bool {
+	return c.getBool("strictFileInteractability")
+}
+
+// SetWindowRect sets whether the remote end has to support resizing and repositioning windows.
+func (c Capabilities) SetWindowRect(setWindowRect bool) Capabilities {
+	c["setWindowRect"] = setWindowRect
+	return c
+}
+
+// WindowRect reports whether the remote end supports resizing and repositioning windows.
+func (c Capabilities) WindowRect() bool {
+	return c.getBool("setWindowRect")
+}
+
+// SetUserAgent sets the desired default user agent of the browser.
+func (c Capabilities) SetUserAgent(userAgent string) Capabilities {
+	c["userAgent"] = userAgent
+	return c
+}
+
+// UserAgent returns the default user agent of the browser.
+func (c Capabilities) UserAgent() string {
+	return c.getString("userAgent")
+}
+
 func (c Capabilities) SetWebSocketURL(webSocketURL bool) Capabilities {
 	c["webSocketUrl"] = webSocketURL
 	return c
 }
 
+// WebSocketURL returns the BiDi websocket URL of a session. It is empty if the capability was
+// not returned by the remote end.
 func (c Capabilities) WebSocketURL() string {
-	if val, ok := c["webSocketUrl"]; ok {
-		return val.(string)
-	}
-
-	return ""
+	return c.getString("webSocketUrl")
 }
 
 // Sets an arbitrary key-value pair
-func (c Capabilities) Set(key string, value string) Capabilities {
+func (c Capabilities) Set(key string, value interface{}) Capabilities {
 	c[key] = value
 	return c
 }
 
+// Get returns the value of a capability.
+func (c Capabilities) Get(key string) (interface{}, bool) {
+	val, ok := c[key]
+	return val, ok
+}
+
+func (c Capabilities) getString(key string) string {
+	var val string
+
+	c.decode(key, &val)
+
+	return val
+}
+
+func (c Capabilities) getBool(key string) bool {
+	var val bool
+
+	c.decode(key, &val)
+
+	return val
+}
+
+// decode converts a capability into out. Values set locally and values decoded from a response
+// have different Go types, so the conversion goes through JSON.
+func (c Capabilities) decode(key string, out interface{}) bool {
+	return decodeValue(c[key], out)
+}
+
+func decodeValue(val interface{}, out interface{}) bool {
+	if val == nil {
+		return false
+	}
+
+	data, err := json.Marshal(val)
+	if err != nil {
+		return false
+	}
+
+	return json.Unmarshal(data, out) == nil
+}
+
+// Validate checks the values of the capabilities defined by the specification. Other capabilities
+// are passed to the remote end as they are.
+//
+// See: https://www.w3.org/TR/webdriver/#dfn-validate-capabilities
+func (c Capabilities) Validate() error {
+	return c.validate(false)
+}
+
+// ValidateStrict is like Validate, but also rejects capabilities that are neither defined by the
+// specification nor prefixed by a vendor, like goog:chromeOptions.
+func (c Capabilities) ValidateStrict() error {
+	return c.validate(true)
+}
+
+func (c Capabilities) validate(strict bool) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	var generic map[string]interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	for name, val := range generic {
+		if val == nil {
+			continue
+		}
+
+		if err := validateCapability(name, val, strict); err != nil {
+			return fmt.Errorf("invalid capability %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCapability(name string, val interface{}, strict bool) error {
+	switch name {
+	case "acceptInsecureCerts", "strictFileInteractability", "webSocketUrl", "setWindowRect":
+		if _, ok := val.(bool); !ok {
+			return fmt.Errorf("expected boolean, got %T", val)
+		}
+	case "browserName", "browserVersion", "platformName", "userAgent":
+		if _, ok := val.(string); !ok {
+			return fmt.Errorf("expected string, got %T", val)
+		}
+	case "pageLoadStrategy":
+		switch PageLoadStrategy(fmt.Sprint(val)) {
+		case PageLoadStrategyNone, PageLoadStrategyEager, PageLoadStrategyNormal:
+		default:
+			return fmt.Errorf("unknown page load strategy %v", val)
+		}
+	case "unhandledPromptBehavior":
+		// The behavior is either a string or an object with a behavior per prompt type.
+		behaviors, ok := val.(map[string]interface{})
+		if !ok {
+			return validateUnhandledPromptBehavior(val)
+		}
+
+		for promptType, behavior := range behaviors {
+			if err := validateUnhandledPromptBehavior(behavior); err != nil {
+				return fmt.Errorf("%s: %w", promptType, err)
+			}
+		}
+	case "timeouts":
+		timeouts, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected object, got %T", val)
+		}
+
+		for key, t := range timeouts {
+			if key != "script" && key != "pageLoad" && key != "implicit" {
+				return fmt.Errorf("unknown timeout %s", key)
+			}
+
+			// Only the script timeout may be null, which means scripts never time out.
+			if t == nil && key == "script" {
+				continue
+			}
+
+			f, ok := t.(float64)
+			if !ok || f < 0 || f > 1<<53-1 || f != math.Trunc(f) {
+				return fmt.Errorf("timeout %s must be an integer between 0 and 2^53 - 1", key)
+			}
+		}
+	case "proxy":
+		proxy, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected object, got %T", val)
+		}
+
+		switch proxy["proxyType"] {
+		case "pac", "direct", "autodetect", "system", "manual":
+		default:
+			return fmt.Errorf("unknown proxy type %v", proxy["proxyType"])
+		}
+	default:
+		// Additional capabilities must be prefixed by a vendor, for example goog:chromeOptions.
+		if strict && !strings.Contains(name, ":") {
+			return fmt.Errorf("unknown capability")
+		}
+	}
+
+	return nil
+}
+
+func validateUnhandledPromptBehavior(val interface{}) error {
+	behavior, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("expected string or object, got %T", val)
+	}
+
+	switch UnhandledPromptBehavior(behavior) {
+	case UnhandledPromptBehaviorDismiss, UnhandledPromptBehaviorAccept, UnhandledPromptBehaviorDismissAndNotify,
+		UnhandledPromptBehaviorAcceptAndNotify, UnhandledPromptBehaviorIgnore:
+		return nil
+	default:
+		return fmt.Errorf("unknown unhandled prompt behavior %s", behavior)
+	}
+}
+
+// MergeCapabilities merges each first match entry into alwaysMatch. It returns an error if a
+// capability is defined in both, or if any of them is invalid.
+//
+// See: https://www.w3.org/TR/webdriver/#dfn-merging-capabilities
+func MergeCapabilities(alwaysMatch Capabilities, firstMatch []Capabilities) ([]Capabilities, error) {
+	if err := alwaysMatch.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(firstMatch) == 0 {
+		firstMatch = []Capabilities{{}}
+	}
+
+	merged := make([]Capabilities, 0, len(firstMatch))
+
+	for i, fm := range firstMatch {
+		if err := fm.Validate(); err != nil {
+			return nil, fmt.Errorf("firstMatch[%d]: %w", i, err)
+		}
+
+		caps := Capabilities{}
+
+		for name, val := range alwaysMatch {
+			caps[name] = val
+		}
+
+		for name, val := range fm {
+			if _, ok := alwaysMatch[name]; ok {
+				return nil, fmt.Errorf("firstMatch[%d]: capability %s is already defined in alwaysMatch", i, name)
+			}
+
+			caps[name] = val
+		}
+
+		merged = append(merged, caps)
+	}
+
+	return merged, nil
+}
+
 /****************************************************************************************************************
  *                                                Chrome Options                                                *
  ****************************************************************************************************************/
 
+// ChromeOptions are the options of chromedriver. Values decoded from JSON, for example from a
+// capability file, are converted when they are read.
+//
+// See: https://chromedriver.chromium.org/capabilities
 type ChromeOptions map[string]interface{}
 
 func (c Capabilities) SetChromeOptions(co ChromeOptions) Capabilities {
@@ -78,40 +407,255 @@ func (c Capabilities) SetChromeOptions(co ChromeOptions) Capabilities {
 }
 
 func (c Capabilities) ChromeOptions() ChromeOptions {
-	if opts, ok := c["goog:chromeOptions"]; ok {
-		return opts.(map[string]interface{})
+	switch opts := c["goog:chromeOptions"].(type) {
+	case ChromeOptions:
+		return opts
+	case map[string]interface{}:
+		return opts
 	}
 
 	return nil
 }
 
-func (co ChromeOptions) AddArg(arg string) ChromeOptions {
-	if _, ok := co["args"]; ok {
-		co["args"] = append(co["args"].([]string), arg)
-	} else {
-		co["args"] = []string{arg}
+// SetEdgeOptions sets the options of msedgedriver, which shares the Chromium option model.
+func (c Capabilities) SetEdgeOptions(eo ChromeOptions) Capabilities {
+	c["ms:edgeOptions"] = eo
+	return c
+}
+
+func (c Capabilities) EdgeOptions() ChromeOptions {
+	switch opts := c["ms:edgeOptions"].(type) {
+	case ChromeOptions:
+		return opts
+	case map[string]interface{}:
+		return opts
 	}
 
+	return nil
+}
+
+func (co ChromeOptions) AddArg(arg string) ChromeOptions {
+	co["args"] = append(co.Args(), arg)
 	return co
 }
 
+func (co ChromeOptions) Args() []string {
+	return co.getStrings("args")
+}
+
 func (co ChromeOptions) SetBinary(binary string) ChromeOptions {
 	co["binary"] = binary
 	return co
 }
 
 func (co ChromeOptions) Binary() string {
-	if val, ok := co["binary"]; ok {
-		return val.(string)
+	return co.getString("binary")
+}
+
+// AddExtension adds a packed extension (.crx).
+func (co ChromeOptions) AddExtension(crx []byte) ChromeOptions {
+	co["extensions"] = append(co.getStrings("extensions"), base64.StdEncoding.EncodeToString(crx))
+	return co
+}
+
+// AddExtensionFile adds the packed extension (.crx) stored in the named file.
+func (co ChromeOptions) AddExtensionFile(name string) error {
+	crx, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+
+	co.AddExtension(crx)
+
+	return nil
+}
+
+// Extensions returns the packed extensions.
+func (co ChromeOptions) Extensions() ([][]byte, error) {
+	encoded := co.getStrings("extensions")
+	extensions := make([][]byte, 0, len(encoded))
+
+	for _, e := range encoded {
+		crx, err := base64.StdEncoding.DecodeString(e)
+		if err != nil {
+			return nil, err
+		}
+
+		extensions = append(extensions, crx)
+	}
+
+	return extensions, nil
+}
+
+// SetPref sets a user profile preference. Nested preferences use dots, for example
+// download.default_directory.
+func (co ChromeOptions) SetPref(name string, value interface{}) ChromeOptions {
+	prefs := co.Prefs()
+	if prefs == nil {
+		prefs = map[string]interface{}{}
+	}
+
+	prefs[name] = value
+	co["prefs"] = prefs
+
+	return co
+}
+
+func (co ChromeOptions) SetPrefs(prefs map[string]interface{}) ChromeOptions {
+	co["prefs"] = prefs
+	return co
+}
+
+func (co ChromeOptions) Prefs() map[string]interface{} {
+	return co.getMap("prefs")
+}
+
+// SetLocalState sets the preferences of the local state file in the user data directory.
+func (co ChromeOptions) SetLocalState(state map[string]interface{}) ChromeOptions {
+	co["localState"] = state
+	return co
+}
+
+func (co ChromeOptions) LocalState() map[string]interface{} {
+	return co.getMap("localState")
+}
+
+// SetDetach keeps the browser running after chromedriver quits.
+func (co ChromeOptions) SetDetach(detach bool) ChromeOptions {
+	co["detach"] = detach
+	return co
+}
+
+func (co ChromeOptions) Detach() bool {
+	var detach bool
+
+	decodeValue(co["detach"], &detach)
+
+	return detach
+}
+
+// AddExcludeSwitch prevents chromedriver from passing a default switch, for example enable-automation.
+func (co ChromeOptions) AddExcludeSwitch(switches ...string) ChromeOptions {
+	co["excludeSwitches"] = append(co.ExcludeSwitches(), switches...)
+	return co
+}
+
+func (co ChromeOptions) ExcludeSwitches() []string {
+	return co.getStrings("excludeSwitches")
+}
+
+// SetMinidumpPath sets the directory crash dumps are written to. Linux only.
+func (co ChromeOptions) SetMinidumpPath(path string) ChromeOptions {
+	co["minidumpPath"] = path
+	return co
+}
+
+func (co ChromeOptions) MinidumpPath() string {
+	return co.getString("minidumpPath")
+}
+
+// MobileEmulation emulates a device either by name or by its metrics and user agent.
+type MobileEmulation struct {
+	// Name of a device from the DevTools emulation panel. Excludes DeviceMetrics and UserAgent.
+	DeviceName    string         `json:"deviceName,omitempty"`
+	DeviceMetrics *DeviceMetrics `json:"deviceMetrics,omitempty"`
+	UserAgent     string         `json:"userAgent,omitempty"`
+}
+
+type DeviceMetrics struct {
+	Width      int     `json:"width,omitempty"`
+	Height     int     `json:"height,omitempty"`
+	PixelRatio float64 `json:"pixelRatio,omitempty"`
+	Touch      *bool   `json:"touch,omitempty"`
+	Mobile     *bool   `json:"mobile,omitempty"`
+}
+
+func (co ChromeOptions) SetMobileEmulation(emulation MobileEmulation) ChromeOptions {
+	co["mobileEmulation"] = emulation
+	return co
+}
+
+func (co ChromeOptions) MobileEmulation() *MobileEmulation {
+	emulation := &MobileEmulation{}
+	if !decodeValue(co["mobileEmulation"], emulation) {
+		return nil
 	}
 
-	return ""
+	return emulation
+}
+
+// PerfLoggingPrefs configures performance logging. It requires the performance log type to be enabled.
+type PerfLoggingPrefs struct {
+	EnableNetwork *bool `json:"enableNetwork,omitempty"`
+	EnablePage    *bool `json:"enablePage,omitempty"`
+
+	// Comma-separated trace categories, for example devtools.timeline
+	TraceCategories string `json:"traceCategories,omitempty"`
+
+	// Requested number of milliseconds between DevTools trace buffer usage events
+	BufferUsageReportingInterval int `json:"bufferUsageReportingInterval,omitempty"`
+}
+
+func (co ChromeOptions) SetPerfLoggingPrefs(prefs PerfLoggingPrefs) ChromeOptions {
+	co["perfLoggingPrefs"] = prefs
+	return co
+}
+
+func (co ChromeOptions) PerfLoggingPrefs() *PerfLoggingPrefs {
+	prefs := &PerfLoggingPrefs{}
+	if !decodeValue(co["perfLoggingPrefs"], prefs) {
+		return nil
+	}
+
+	return prefs
+}
+
+// SetWindowTypes sets the window types that appear in the window handle list, for example webview.
+func (co ChromeOptions) SetWindowTypes(types ...string) ChromeOptions {
+	co["windowTypes"] = types
+	return co
+}
+
+func (co ChromeOptions) WindowTypes() []string {
+	return co.getStrings("windowTypes")
+}
+
+// SetDownloadDirectory sets the preferences that make Chrome save downloads to dir without prompting.
+func (co ChromeOptions) SetDownloadDirectory(dir string) ChromeOptions {
+	return co.
+		SetPref("download.default_directory", dir).
+		SetPref("download.prompt_for_download", false).
+		SetPref("download.directory_upgrade", true)
 }
 
 func (co ChromeOptions) DebuggerAddress() string {
-	if val, ok := co["debuggerAddress"]; ok {
-		return val.(string)
+	return co.getString("debuggerAddress")
+}
+
+func (co ChromeOptions) getString(key string) string {
+	var val string
+
+	decodeValue(co[key], &val)
+
+	return val
+}
+
+func (co ChromeOptions) getStrings(key string) []string {
+	var val []string
+
+	decodeValue(co[key], &val)
+
+	return val
+}
+
+func (co ChromeOptions) getMap(key string) map[string]interface{} {
+	if val, ok := co[key].(map[string]interface{}); ok {
+		return val
 	}
 
-	return ""
+	var val map[string]interface{}
+
+	decodeValue(co[key], &val)
+
+	return val
 }
@@ -1,6 +1,7 @@
 package webdriver
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,3 +21,98 @@ func TestChromeOptions(t *testing.T) {
 	co.AddArg("--disable-blink-features=AutomationControlled")
 	assert.ElementsMatch(t, co["args"], []string{"--headless", "--disable-blink-features=AutomationControlled"})
 }
+
+func TestChromeOptionsSetDownloadDirectory(t *testing.T) {
+	co := ChromeOptions{}
+	co.SetDownloadDirectory("/tmp/downloads")
+
+	prefs := co["prefs"].(map[string]interface{})
+	assert.Equal(t, "/tmp/downloads", prefs["download.default_directory"])
+	assert.Equal(t, false, prefs["download.prompt_for_download"])
+}
+
+func TestCapabilitiesGetters(t *testing.T) {
+	caps := Capabilities{}
+	caps.SetWebSocketURL(true)
+	caps.SetPageLoadStrategy(PageLoadStrategyEager)
+	caps.SetTimeouts(Timeouts{Script: 1000, PageLoad: 2000, Implicit: 0})
+	caps.SetChromeOptions(ChromeOptions{}.SetBinary("/usr/bin/chromium"))
+
+	assert.Equal(t, "", caps.WebSocketURL())
+	assert.Equal(t, PageLoadStrategyEager, caps.PageLoadStrategy())
+	assert.Equal(t, &Timeouts{Script: 1000, PageLoad: 2000}, caps.Timeouts())
+	assert.Equal(t, "/usr/bin/chromium", caps.ChromeOptions().Binary())
+
+	caps["webSocketUrl"] = "ws://127.0.0.1:9222/session/1"
+	assert.Equal(t, "ws://127.0.0.1:9222/session/1", caps.WebSocketURL())
+}
+
+func TestCapabilitiesValidate(t *testing.T) {
+	assert.NoError(t, Capabilities{}.SetBrowserName("chrome").SetUnhandledPromptBehavior(UnhandledPromptBehaviorIgnore).Validate())
+	assert.Error(t, Capabilities{"pageLoadStrategy": "fast"}.Validate())
+	assert.Error(t, Capabilities{"timeouts": map[string]interface{}{"implicit": -1}}.Validate())
+	assert.NoError(t, Capabilities{"chromeOptions": map[string]interface{}{}}.Validate())
+	assert.Error(t, Capabilities{"chromeOptions": map[string]interface{}{}}.ValidateStrict())
+	assert.NoError(t, Capabilities{"unhandledPromptBehavior": map[string]interface{}{"alert": "accept", "default": "ignore"}}.Validate())
+	assert.Error(t, Capabilities{"unhandledPromptBehavior": map[string]interface{}{"alert": "close"}}.Validate())
+	assert.Error(t, Capabilities{}.SetProxy(ProxyConfig{}).Validate())
+}
+
+func TestMergeCapabilities(t *testing.T) {
+	merged, err := MergeCapabilities(Capabilities{"browserName": "chrome"}, []Capabilities{
+		{"platformName": "linux"},
+		{"platformName": "windows"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Capabilities{
+		{"browserName": "chrome", "platformName": "linux"},
+		{"browserName": "chrome", "platformName": "windows"},
+	}, merged)
+
+	_, err = MergeCapabilities(Capabilities{"browserName": "chrome"}, []Capabilities{{"browserName": "firefox"}})
+	assert.Error(t, err)
+}
+
+func TestChromeOptionsRoundTrip(t *testing.T) {
+	mobile := true
+
+	co := ChromeOptions{}.
+		AddArg("--headless").
+		AddExtension([]byte("crx")).
+		SetPref("intl.accept_languages", "de").
+		SetDetach(true).
+		AddExcludeSwitch("enable-automation").
+		SetMobileEmulation(MobileEmulation{DeviceMetrics: &DeviceMetrics{Width: 360, Height: 640, Mobile: &mobile}}).
+		SetWindowTypes("webview")
+
+	data, err := json.Marshal(Capabilities{}.SetChromeOptions(co))
+	assert.NoError(t, err)
+
+	caps := Capabilities{}
+	assert.NoError(t, json.Unmarshal(data, &caps))
+
+	decoded := caps.ChromeOptions()
+	assert.Equal(t, []string{"--headless"}, decoded.Args())
+	assert.Equal(t, "de", decoded.Prefs()["intl.accept_languages"])
+	assert.True(t, decoded.Detach())
+	assert.Equal(t, []string{"enable-automation"}, decoded.ExcludeSwitches())
+	assert.Equal(t, 360, decoded.MobileEmulation().DeviceMetrics.Width)
+	assert.Equal(t, []string{"webview"}, decoded.WindowTypes())
+
+	extensions, err := decoded.Extensions()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("crx")}, extensions)
+
+	decoded.AddArg("--mute-audio")
+	assert.Equal(t, []string{"--headless", "--mute-audio"}, decoded.Args())
+}
+
+func TestEdgeOptions(t *testing.T) {
+	caps := newDefaultEdgeDriverCapabilities()
+	caps.SetEdgeOptions(ChromeOptions{}.AddArg("--headless"))
+
+	assert.Equal(t, "MicrosoftEdge", caps.BrowserName())
+	assert.Equal(t, []string{"--headless"}, caps.EdgeOptions().Args())
+	assert.Nil(t, caps.ChromeOptions())
+	assert.NoError(t, caps.Validate())
+}
@@ -1,42 +1,39 @@
 package webdriver
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
+
+// chromeDriverStartedLine is printed by chromedriver once it listens on its port.
+const chromeDriverStartedLine = "ChromeDriver was started successfully"
 
 type chromeDriver struct {
 	webDriver
-	path string
 }
 
+// NewChromeDriver returns a WebDriver backed by the chromedriver at path. If path is empty, a driver
+// matching the installed Chrome is resolved with a DriverResolver.
 func NewChromeDriver(path string, optFns ...func(o *Options)) (WebDriver, error) {
 	opts := Options{
-		Port:        0,
-		BootTimeout: 10 * time.Second, //nolint gomnd
+		Port:           0,
+		BootTimeout:    10 * time.Second, //nolint gomnd
+		ResolveTimeout: 5 * time.Minute,  //nolint gomnd
 	}
 
 	for _, fn := range optFns {
 		fn(&opts)
 	}
 
-	if opts.Port == 0 {
-		port, err := GetFreePort()
-		if err != nil {
+	if path == "" {
+		var err error
+		if path, err = opts.resolveDriver(BrowserChrome); err != nil {
 			return nil, err
 		}
-
-		opts.Port = port
 	}
 
-	cd := &chromeDriver{
-		path: path,
-	}
+	cd := &chromeDriver{}
 
-	cd.port = opts.Port
-	cd.service = NewService(path, []string{fmt.Sprintf("--port=%d", opts.Port)})
-	cd.timeout = opts.BootTimeout
-	cd.client = NewRestClient(fmt.Sprintf("http://127.0.0.1:%d", opts.Port))
+	if err := cd.init(path, chromeDriverStartedLine, opts); err != nil {
+		return nil, err
+	}
 
 	return cd, nil
 }
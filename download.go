@@ -0,0 +1,123 @@
+package webdriver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Download is a file downloaded by the browser.
+type Download struct {
+	Path string
+	Data []byte
+}
+
+// partialDownloadSuffixes are used by browsers for downloads in progress.
+var partialDownloadSuffixes = []string{".crdownload", ".part", ".tmp", ".download"}
+
+// NewDownloadDirectory creates a temporary directory for downloads. Use it with
+// ChromeOptions.SetDownloadDirectory and remove it once the session is closed.
+func NewDownloadDirectory() (string, error) {
+	return os.MkdirTemp("", "gowebdriver-downloads-")
+}
+
+// WaitForDownload runs trigger, for example a click on a download link, and waits until a new file
+// in dir has been completely written.
+func WaitForDownload(ctx context.Context, dir string, trigger func() error) (*Download, error) {
+	existing, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := trigger(); err != nil {
+		return nil, err
+	}
+
+	sizes := map[string]int64{}
+
+	ticker := time.NewTicker(100 * time.Millisecond) // nolint gomnd
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		files, err := listFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, size := range files {
+			if _, ok := existing[name]; ok || isPartialDownload(name) {
+				continue
+			}
+
+			// The download is complete once its size is stable and no partial file is left.
+			if last, ok := sizes[name]; ok && last == size && !hasPartialDownload(files) {
+				path := filepath.Join(dir, name)
+
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return nil, err
+				}
+
+				return &Download{
+					Path: path,
+					Data: data,
+				}, nil
+			}
+
+			sizes[name] = size
+		}
+	}
+}
+
+func listFiles(dir string) (map[string]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]int64{}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// The file may have been renamed in the meantime.
+			continue
+		}
+
+		files[entry.Name()] = info.Size()
+	}
+
+	return files, nil
+}
+
+func isPartialDownload(name string) bool {
+	for _, suffix := range partialDownloadSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPartialDownload(files map[string]int64) bool {
+	for name := range files {
+		if isPartialDownload(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package webdriver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForDownload(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "old.csv"), []byte("old"), 0600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	download, err := WaitForDownload(ctx, dir, func() error {
+		go func() {
+			partial := filepath.Join(dir, "export.csv.crdownload")
+			_ = os.WriteFile(partial, []byte("a,b\n"), 0600)
+			time.Sleep(250 * time.Millisecond)
+			_ = os.Rename(partial, filepath.Join(dir, "export.csv"))
+		}()
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "export.csv"), download.Path)
+	assert.Equal(t, []byte("a,b\n"), download.Data)
+}
@@ -0,0 +1,59 @@
+package webdriver
+
+import "time"
+
+// edgeDriverStartedLine is printed by msedgedriver once it listens on its port.
+const edgeDriverStartedLine = "Microsoft Edge WebDriver was started successfully"
+
+type edgeDriver struct {
+	webDriver
+}
+
+// NewEdgeDriver returns a WebDriver for Microsoft Edge backed by the msedgedriver at path. If path is
+// empty, a driver matching the installed Edge is resolved with a DriverResolver.
+func NewEdgeDriver(path string, optFns ...func(o *Options)) (WebDriver, error) {
+	opts := Options{
+		Port:           0,
+		BootTimeout:    10 * time.Second, //nolint gomnd
+		ResolveTimeout: 5 * time.Minute,  //nolint gomnd
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	if path == "" {
+		var err error
+		if path, err = opts.resolveDriver(BrowserEdge); err != nil {
+			return nil, err
+		}
+	}
+
+	ed := &edgeDriver{}
+
+	if err := ed.init(path, edgeDriverStartedLine, opts); err != nil {
+		return nil, err
+	}
+
+	return ed, nil
+}
+
+func (d *edgeDriver) NewSession(optFns ...func(o *SessionOptions)) (*Session, error) {
+	opts := SessionOptions{
+		AlwaysMatch: newDefaultEdgeDriverCapabilities(),
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	return d.newSession(opts)
+}
+
+func newDefaultEdgeDriverCapabilities() Capabilities {
+	caps := Capabilities{}
+	caps.SetBrowserName("MicrosoftEdge")
+	caps.SetWebSocketURL(true)
+
+	return caps
+}
@@ -4,12 +4,78 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sync"
 )
 
+// elementKey identifies a web element reference.
+const elementKey = "element-6066-11e4-a52e-4f735466cecf"
+
 type Element struct {
 	ID        string      `json:"element-6066-11e4-a52e-4f735466cecf"`
 	SessionID string      `json:"-"`
 	client    *RestClient `json:"-"`
+
+	// locate finds the element again before each command if it is set. ID holds the last found element.
+	locate *locator
+}
+
+// locator finds a bound element. Copies of the element share it, and its lock guards the lookup and
+// the update of ID.
+type locator struct {
+	mu   sync.Mutex
+	find func() (*Element, error)
+}
+
+func newLocator(find func() (*Element, error)) *locator {
+	return &locator{find: find}
+}
+
+// ref returns the ID of the element, finding it again if it is bound to a locator.
+func (e *Element) ref() (string, error) {
+	if e.locate == nil {
+		return e.ID, nil
+	}
+
+	e.locate.mu.Lock()
+	defer e.locate.mu.Unlock()
+
+	element, err := e.locate.find()
+	if err != nil {
+		return "", err
+	}
+
+	e.ID = element.ID
+
+	return e.ID, nil
+}
+
+// MarshalJSON serializes the element as a web element reference. An element bound to a locator is
+// found first, so marshaling it sends a request to the driver and fails if it is not found.
+func (e Element) MarshalJSON() ([]byte, error) {
+	id, err := e.ref()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]string{elementKey: id})
+}
+
+func (e *Element) get(command string) ([]byte, error) {
+	id, err := e.ref()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.client.Get(fmt.Sprintf("/session/%s/element/%s/%s", e.SessionID, id, command))
+}
+
+func (e *Element) post(command string, data *Params) ([]byte, error) {
+	id, err := e.ref()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.client.Post(fmt.Sprintf("/session/%s/element/%s/%s", e.SessionID, id, command), data)
 }
 
 /****************************************************************************************************************
@@ -19,7 +85,7 @@ type Element struct {
 
 // GetShadowRoot returns a shadow root of the element if there is one or an error.
 func (e *Element) GetShadowRoot() (*ShadowRoot, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/shadow", e.SessionID, e.ID))
+	data, err := e.get("shadow")
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +103,7 @@ func (e *Element) GetShadowRoot() (*ShadowRoot, error) {
 
 // FindElement searches for an element on the page, starting from the referenced web element.
 func (e *Element) FindElement(strategy LocatorStrategy, selector string) (*Element, error) {
-	data, err := e.client.Post(fmt.Sprintf("/session/%s/element/%s", e.SessionID, e.ID), &Params{
+	data, err := e.post("element", &Params{
 		"using": strategy,
 		"value": selector,
 	})
@@ -61,7 +127,7 @@ func (e *Element) FindElement(strategy LocatorStrategy, selector string) (*Eleme
 // strategies that each server should support. Elements should be returned in the order located
 // in the DOM.
 func (e *Element) FindElements(strategy LocatorStrategy, selector string) ([]Element, error) {
-	data, err := e.client.Post(fmt.Sprintf("/session/%s/elements/%s", e.SessionID, e.ID), &Params{
+	data, err := e.post("elements", &Params{
 		"using": strategy,
 		"value": selector,
 	})
@@ -82,10 +148,20 @@ func (e *Element) FindElements(strategy LocatorStrategy, selector string) ([]Ele
 	return elements, nil
 }
 
+// FindElementBy returns the first element located by by, starting from the referenced web element.
+func (e *Element) FindElementBy(by By) (*Element, error) {
+	return findElementBy(e, by)
+}
+
+// FindElementsBy returns the elements located by by, starting from the referenced web element.
+func (e *Element) FindElementsBy(by By) ([]Element, error) {
+	return by.FindElements(e)
+}
+
 // IsSelected determines if the referenced element is selected or not.
 // This operation only makes sense on input elements of the Checkbox- and Radio Button states, or on option elements.
 func (e *Element) IsSelected() (bool, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/selected", e.SessionID, e.ID))
+	data, err := e.get("selected")
 	if err != nil {
 		return false, err
 	}
@@ -98,7 +174,7 @@ func (e *Element) IsSelected() (bool, error) {
 
 // GetAttribute returns the attribute value of the referenced web element.
 func (e *Element) GetAttribute(name string) (string, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/attribute/%s", e.SessionID, e.ID, name))
+	data, err := e.get(fmt.Sprintf("attribute/%s", name))
 	if err != nil {
 		return "", err
 	}
@@ -111,7 +187,7 @@ func (e *Element) GetAttribute(name string) (string, error) {
 
 // GetProperty returns the property of the referenced web element.
 func (e *Element) GetProperty(name string) (string, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/property/%s", e.SessionID, e.ID, name))
+	data, err := e.get(fmt.Sprintf("property/%s", name))
 	if err != nil {
 		return "", err
 	}
@@ -124,7 +200,7 @@ func (e *Element) GetProperty(name string) (string, error) {
 
 // GetCSSValue returns the computed value of the given CSS property for the element.
 func (e *Element) GetCSSValue(name string) (string, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/css/%s", e.SessionID, e.ID, name))
+	data, err := e.get(fmt.Sprintf("css/%s", name))
 	if err != nil {
 		return "", err
 	}
@@ -137,7 +213,7 @@ func (e *Element) GetCSSValue(name string) (string, error) {
 
 // GetText returns the visible text for the element.
 func (e *Element) GetText() (string, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/text", e.SessionID, e.ID))
+	data, err := e.get("text")
 	if err != nil {
 		return "", err
 	}
@@ -150,7 +226,7 @@ func (e *Element) GetText() (string, error) {
 
 // GetTagName returns the tagName of an element
 func (e *Element) GetTagName() (string, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/name", e.SessionID, e.ID))
+	data, err := e.get("name")
 	if err != nil {
 		return "", err
 	}
@@ -164,21 +240,21 @@ func (e *Element) GetTagName() (string, error) {
 // ElementRect defines the Element Rect.
 type ElementRect struct {
 	// X axis position of the top-left corner of the element relative to the current browsing context's document element in CSS pixels
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y axis position of the top-left corner of the element relative to the current browsing context's document element in CSS pixels
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
 	// Height of the element's bounding rectangle in CSS pixels
-	Width int `json:"width"`
+	Width float64 `json:"width"`
 
 	// Width of the web element's bounding rectangle in CSS pixels
-	Height int `json:"height"`
+	Height float64 `json:"height"`
 }
 
 // Returns the dimensions and coordinates of the referenced element
 func (e *Element) GetRect() (*ElementRect, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/rect", e.SessionID, e.ID))
+	data, err := e.get("rect")
 	if err != nil {
 		return nil, err
 	}
@@ -191,7 +267,7 @@ func (e *Element) GetRect() (*ElementRect, error) {
 
 // IsEnabled determines if the referenced element is enabled or not.
 func (e *Element) IsEnabled() (bool, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/enabled", e.SessionID, e.ID))
+	data, err := e.get("enabled")
 	if err != nil {
 		return false, err
 	}
@@ -204,19 +280,19 @@ func (e *Element) IsEnabled() (bool, error) {
 
 // Click clicks on an element.
 func (e *Element) Click() error {
-	_, err := e.client.Post(fmt.Sprintf("/session/%s/element/%s/click", e.SessionID, e.ID), nil)
+	_, err := e.post("click", nil)
 	return err
 }
 
 // Clear clears content of an element.
 func (e *Element) Clear() error {
-	_, err := e.client.Post(fmt.Sprintf("/session/%s/element/%s/clear", e.SessionID, e.ID), nil)
+	_, err := e.post("clear", nil)
 	return err
 }
 
 // SendKeys sends a sequence of key strokes to an element.
 func (e *Element) SendKeys(text string) error {
-	_, err := e.client.Post(fmt.Sprintf("/session/%s/element/%s/value", e.SessionID, e.ID), &Params{
+	_, err := e.post("value", &Params{
 		"text": text,
 	})
 
@@ -230,7 +306,7 @@ func (e *Element) SendKeys(text string) error {
 
 // TakeScreenshot takes a screenshot of the visible region encompassed by the bounding rectangle of an element.
 func (e *Element) TakeScreenshot() ([]byte, error) {
-	data, err := e.client.Get(fmt.Sprintf("/session/%s/element/%s/screenshot", e.SessionID, e.ID))
+	data, err := e.get("screenshot")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The parser understands the subset of CDDL (RFC 8610) used by the WebDriver BiDi specification.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var (
+		tokens []token
+		line   = 1
+		runes  = []rune(src)
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == ';':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+
+			if j >= len(runes) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, value: string(runes[i+1 : j]), line: line})
+			i = j + 1
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || (runes[j] == '.' && j+1 < len(runes) && unicode.IsDigit(runes[j+1]))) {
+				j++
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[i:j]), line: line})
+			i = j
+		case isIdentStart(r) || (r == '.' && i+1 < len(runes) && unicode.IsLetter(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && isIdentPart(runes[j]) {
+				j++
+			}
+
+			// Identifiers must not end with a dot or dash.
+			for j > i+1 && (runes[j-1] == '.' || runes[j-1] == '-') {
+				j--
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, value: string(runes[i:j]), line: line})
+			i = j
+		default:
+			punct := string(r)
+
+			for _, p := range []string{"...", "..", "//", "=>"} {
+				if strings.HasPrefix(string(runes[i:]), p) {
+					punct = p
+					break
+				}
+			}
+
+			if !strings.Contains("...//=>(){}[],:?*+/=", punct) {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, r)
+			}
+
+			tokens = append(tokens, token{kind: tokenPunct, value: punct, line: line})
+			i += len([]rune(punct))
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line}), nil
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$' || r == '@'
+}
+
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r) || r == '.' || r == '-'
+}
+
+// Rule is a named type or group definition.
+type Rule struct {
+	Name   string
+	Type   *Type
+	Source string
+}
+
+// Type is a choice of one or more alternatives separated by "/".
+type Type struct {
+	Choices []*Type1
+}
+
+type type1Kind int
+
+const (
+	kindName type1Kind = iota
+	kindString
+	kindNumber
+	kindRange
+	kindMap
+	kindArray
+	kindGroup
+)
+
+// Type1 is a single alternative of a type.
+type Type1 struct {
+	Kind  type1Kind
+	Value string // name or literal value
+	Group *Group // map, array and group contents
+	Float bool   // range with floating point bounds
+}
+
+// Group is a choice of entry sequences separated by "//".
+type Group struct {
+	Choices [][]*Entry
+}
+
+// Entry is a member of a group. Entries without a key are group references or array items.
+type Entry struct {
+	Occur   string
+	Key     string
+	KeyType *Type
+	Type    *Type
+}
+
+func (e *Entry) Optional() bool {
+	return e.Occur == "?" || e.Occur == "*"
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses CDDL source into its rules in definition order.
+func Parse(src, source string) ([]*Rule, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	var rules []*Rule
+
+	for p.peek().kind != tokenEOF {
+		name := p.next()
+		if name.kind != tokenIdent {
+			return nil, fmt.Errorf("line %d: expected rule name, got %q", name.line, name.value)
+		}
+
+		if err := p.expect("="); err != nil {
+			return nil, err
+		}
+
+		typ, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &Rule{Name: name.value, Type: typ, Source: source})
+	}
+
+	return rules, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+
+	return p.tokens[p.pos+n]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *parser) isPunct(value string) bool {
+	t := p.peek()
+	return t.kind == tokenPunct && t.value == value
+}
+
+func (p *parser) expect(value string) error {
+	t := p.next()
+	if t.kind != tokenPunct || t.value != value {
+		return fmt.Errorf("line %d: expected %q, got %q", t.line, value, t.value)
+	}
+
+	return nil
+}
+
+func (p *parser) parseType() (*Type, error) {
+	typ := &Type{}
+
+	for {
+		t1, err := p.parseType1()
+		if err != nil {
+			return nil, err
+		}
+
+		typ.Choices = append(typ.Choices, t1)
+
+		if !p.isPunct("/") {
+			return typ, nil
+		}
+
+		p.next()
+	}
+}
+
+func (p *parser) parseType1() (*Type1, error) {
+	t1, err := p.parseType2()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isPunct("..") || p.isPunct("...") {
+		p.next()
+
+		upper, err := p.parseType2()
+		if err != nil {
+			return nil, err
+		}
+
+		t1 = &Type1{
+			Kind:  kindRange,
+			Value: t1.Value + ".." + upper.Value,
+			Float: strings.Contains(t1.Value, ".") || strings.Contains(upper.Value, "."),
+		}
+	}
+
+	// Control operators such as .default or .ge only constrain the value.
+	for p.peek().kind == tokenIdent && strings.HasPrefix(p.peek().value, ".") {
+		p.next()
+
+		if _, err := p.parseType2(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t1, nil
+}
+
+func (p *parser) parseType2() (*Type1, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenIdent:
+		return &Type1{Kind: kindName, Value: t.value}, nil
+	case tokenString:
+		return &Type1{Kind: kindString, Value: t.value}, nil
+	case tokenNumber:
+		return &Type1{Kind: kindNumber, Value: t.value}, nil
+	case tokenPunct:
+		closing := map[string]string{"{": "}", "[": "]", "(": ")"}[t.value]
+		if closing == "" {
+			break
+		}
+
+		group, err := p.parseGroup(closing)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(closing); err != nil {
+			return nil, err
+		}
+
+		kind := map[string]type1Kind{"{": kindMap, "[": kindArray, "(": kindGroup}[t.value]
+
+		return &Type1{Kind: kind, Group: group}, nil
+	}
+
+	return nil, fmt.Errorf("line %d: unexpected %q", t.line, t.value)
+}
+
+func (p *parser) parseGroup(closing string) (*Group, error) {
+	group := &Group{Choices: [][]*Entry{nil}}
+
+	for !p.isPunct(closing) {
+		if p.peek().kind == tokenEOF {
+			return nil, fmt.Errorf("line %d: unexpected end of input", p.peek().line)
+		}
+
+		if p.isPunct(",") {
+			p.next()
+			continue
+		}
+
+		if p.isPunct("//") {
+			p.next()
+
+			group.Choices = append(group.Choices, nil)
+
+			continue
+		}
+
+		entry, err := p.parseEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		last := len(group.Choices) - 1
+		group.Choices[last] = append(group.Choices[last], entry)
+	}
+
+	return group, nil
+}
+
+func (p *parser) parseEntry() (*Entry, error) {
+	entry := &Entry{}
+
+	if p.isPunct("?") || p.isPunct("*") || p.isPunct("+") {
+		entry.Occur = p.next().value
+	}
+
+	if k := p.peek(); (k.kind == tokenIdent || k.kind == tokenString) && p.peekAt(1).kind == tokenPunct && p.peekAt(1).value == ":" {
+		entry.Key = k.value
+		p.pos += 2
+
+		typ, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+
+		entry.Type = typ
+
+		return entry, nil
+	}
+
+	typ, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isPunct("=>") {
+		p.next()
+
+		entry.KeyType = typ
+
+		if typ, err = p.parseType(); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.Type = typ
+
+	return entry, nil
+}
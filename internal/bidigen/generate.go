@@ -0,0 +1,754 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+)
+
+type category int
+
+const (
+	categoryStruct category = iota
+	categoryString
+	categoryInt
+	categoryFloat
+	categoryBool
+	categoryReference // slices, maps, raw messages and interfaces
+)
+
+var primitives = map[string]struct {
+	goType   string
+	category category
+}{
+	"text":    {"string", categoryString},
+	"tstr":    {"string", categoryString},
+	"bool":    {"bool", categoryBool},
+	"int":     {"int64", categoryInt},
+	"uint":    {"int64", categoryInt},
+	"js-int":  {"int64", categoryInt},
+	"js-uint": {"int64", categoryInt},
+	"float":   {"float64", categoryFloat},
+	"number":  {"float64", categoryFloat},
+	"any":     {"interface{}", categoryReference},
+}
+
+var initialisms = map[string]bool{
+	"CSS": true, "DNS": true, "DOM": true, "HTML": true, "HTTP": true, "ID": true,
+	"JSON": true, "SSL": true, "TLS": true, "URI": true, "URL": true,
+}
+
+type method struct {
+	name   string
+	method string
+	params string
+}
+
+type generator struct {
+	pkg      string
+	rules    map[string]*Rule
+	order    []*Rule
+	types    map[string]category
+	commands []method
+	events   []method
+	buf      bytes.Buffer
+	inline   bytes.Buffer
+}
+
+// Generate emits Go source for the given rules. Rules from the "remote" source define commands,
+// rules from the "local" source define events.
+func Generate(pkg string, rules []*Rule) ([]byte, error) {
+	g := &generator{
+		pkg:   pkg,
+		rules: map[string]*Rule{},
+		types: map[string]category{},
+	}
+
+	for _, r := range rules {
+		// Shared definitions such as Extensible appear in both files.
+		if _, ok := g.rules[r.Name]; ok {
+			continue
+		}
+
+		g.rules[r.Name] = r
+		g.order = append(g.order, r)
+	}
+
+	for _, r := range g.order {
+		g.classify(r)
+	}
+
+	for _, r := range g.order {
+		if err := g.emit(r); err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Name, err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "// Code generated by bidigen. DO NOT EDIT.\n\npackage %s\n\n", g.pkg)
+
+	if bytes.Contains(g.buf.Bytes(), []byte("json.")) || bytes.Contains(g.inline.Bytes(), []byte("json.")) {
+		fmt.Fprintf(out, "import \"encoding/json\"\n\n")
+	}
+
+	g.emitMethods(out, "Command", "Command", g.commands)
+	g.emitMethods(out, "Event", "Event", g.events)
+
+	out.Write(g.buf.Bytes())
+	out.Write(g.inline.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) emitMethods(out *bytes.Buffer, title, prefix string, methods []method) {
+	if len(methods) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "// %s methods.\nconst (\n", title)
+
+	for _, m := range methods {
+		fmt.Fprintf(out, "\t%s%s = %q\n", prefix, m.name, m.method)
+	}
+
+	fmt.Fprintf(out, ")\n\n")
+
+	fmt.Fprintf(out, "// %sParams maps each method to a new value of its parameters type.\n", prefix)
+	fmt.Fprintf(out, "var %sParams = map[string]func() interface{}{\n", prefix)
+
+	for _, m := range methods {
+		fmt.Fprintf(out, "\t%s%s: func() interface{} { return new(%s) },\n", prefix, m.name, m.params)
+	}
+
+	fmt.Fprintf(out, "}\n\n")
+}
+
+// classify records the Go category of every named type so that fields can decide whether they need a pointer.
+func (g *generator) classify(r *Rule) {
+	if _, ok := primitives[r.Name]; ok {
+		return
+	}
+
+	if m, ok := g.method(r); ok {
+		if r.Source == "remote" {
+			g.commands = append(g.commands, m)
+		} else {
+			g.events = append(g.events, m)
+		}
+
+		return
+	}
+
+	g.types[goName(r.Name)] = g.categoryOf(r.Type, map[string]bool{})
+}
+
+func (g *generator) categoryOf(t *Type, seen map[string]bool) category {
+	choices := withoutNull(t)
+
+	if allStrings(choices) {
+		return categoryString
+	}
+
+	if g.isRecord(choices, map[string]bool{}) {
+		return categoryStruct
+	}
+
+	if len(choices) != 1 {
+		return categoryReference
+	}
+
+	c := choices[0]
+
+	switch c.Kind {
+	case kindName:
+		if p, ok := primitives[c.Value]; ok {
+			return p.category
+		}
+
+		r, ok := g.rules[c.Value]
+		if !ok || seen[c.Value] {
+			return categoryReference
+		}
+
+		seen[c.Value] = true
+
+		return g.categoryOf(r.Type, seen)
+	case kindNumber, kindRange:
+		if isFloat(c) {
+			return categoryFloat
+		}
+
+		return categoryInt
+	case kindMap:
+		if _, ok := mapValue(c.Group); ok {
+			return categoryReference
+		}
+
+		return categoryStruct
+	case kindGroup:
+		if inner, ok := unwrap(c.Group); ok {
+			return g.categoryOf(inner, seen)
+		}
+
+		if hasKeys(c.Group) {
+			return categoryStruct
+		}
+	}
+
+	return categoryReference
+}
+
+// method detects command and event definitions of the form (method: "...", params: ...).
+func (g *generator) method(r *Rule) (method, bool) {
+	if len(r.Type.Choices) != 1 || r.Type.Choices[0].Kind != kindGroup {
+		return method{}, false
+	}
+
+	group := r.Type.Choices[0].Group
+	if len(group.Choices) != 1 {
+		return method{}, false
+	}
+
+	m := method{name: goName(r.Name)}
+
+	for _, e := range group.Choices[0] {
+		switch {
+		case e.Key == "method" && len(e.Type.Choices) == 1 && e.Type.Choices[0].Kind == kindString:
+			m.method = e.Type.Choices[0].Value
+		case e.Key == "params" && len(e.Type.Choices) == 1 && e.Type.Choices[0].Kind == kindName:
+			m.params = goName(e.Type.Choices[0].Value)
+		}
+	}
+
+	return m, m.method != "" && m.params != ""
+}
+
+func (g *generator) emit(r *Rule) error {
+	if _, ok := primitives[r.Name]; ok || r.Name == "Extensible" {
+		return nil
+	}
+
+	if _, ok := g.method(r); ok {
+		return nil
+	}
+
+	name := goName(r.Name)
+	choices := withoutNull(r.Type)
+
+	if allStrings(choices) {
+		g.emitEnum(r, name, choices)
+		return nil
+	}
+
+	if len(choices) == 1 {
+		c := choices[0]
+
+		switch c.Kind {
+		case kindName:
+			// Dispatch groups such as SessionCommand only reference commands.
+			if target, ok := g.rules[c.Value]; ok {
+				if _, ok := g.method(target); ok {
+					return nil
+				}
+			}
+
+			g.comment(r, name)
+
+			if p, ok := primitives[c.Value]; ok {
+				fmt.Fprintf(&g.buf, "type %s %s\n\n", name, p.goType)
+			} else {
+				fmt.Fprintf(&g.buf, "type %s = %s\n\n", name, goName(c.Value))
+			}
+
+			return nil
+		case kindMap:
+			if value, ok := mapValue(c.Group); ok {
+				g.comment(r, name)
+				fmt.Fprintf(&g.buf, "type %s map[string]%s\n\n", name, g.fieldType(value, name+"Value"))
+
+				return nil
+			}
+
+			return g.emitStruct(&g.buf, r, name, c.Group)
+		case kindArray:
+			g.comment(r, name)
+			fmt.Fprintf(&g.buf, "type %s %s\n\n", name, g.fieldType(r.Type, name))
+
+			return nil
+		case kindNumber, kindRange:
+			g.comment(r, name)
+			fmt.Fprintf(&g.buf, "type %s %s\n\n", name, g.fieldType(r.Type, name))
+
+			return nil
+		case kindGroup:
+			if inner, ok := unwrap(c.Group); ok {
+				return g.emit(&Rule{Name: r.Name, Type: inner, Source: r.Source})
+			}
+
+			if !hasKeys(c.Group) {
+				return nil
+			}
+
+			return g.emitStruct(&g.buf, r, name, c.Group)
+		}
+	}
+
+	// Choices between records are merged into a single struct.
+	if g.isRecord(choices, map[string]bool{}) {
+		return g.emitStruct(&g.buf, r, name, alternatives(choices))
+	}
+
+	g.comment(r, name)
+	fmt.Fprintf(&g.buf, "type %s = json.RawMessage\n\n", name)
+
+	return nil
+}
+
+// isRecord reports whether every choice resolves to a map or a group with keys.
+func (g *generator) isRecord(choices []*Type1, seen map[string]bool) bool {
+	if len(choices) < 2 {
+		return false
+	}
+
+	for _, c := range choices {
+		switch c.Kind {
+		case kindMap:
+			if _, ok := mapValue(c.Group); ok {
+				return false
+			}
+		case kindName:
+			r, ok := g.rules[c.Value]
+			if !ok || seen[c.Value] {
+				return false
+			}
+
+			seen[c.Value] = true
+
+			rc := withoutNull(r.Type)
+			if len(rc) == 1 && (rc[0].Kind == kindMap || rc[0].Kind == kindGroup) {
+				if inner, ok := unwrap(rc[0].Group); ok {
+					rc = withoutNull(inner)
+				}
+			}
+
+			if len(rc) == 1 && (rc[0].Kind == kindMap || rc[0].Kind == kindGroup) && hasKeys(rc[0].Group) {
+				continue
+			}
+
+			if !g.isRecord(rc, seen) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// alternatives turns type choices into a group choice of references so that their fields can be merged.
+func alternatives(choices []*Type1) *Group {
+	group := &Group{}
+
+	for _, c := range choices {
+		if c.Kind == kindMap {
+			group.Choices = append(group.Choices, c.Group.Choices...)
+			continue
+		}
+
+		group.Choices = append(group.Choices, []*Entry{{Type: &Type{Choices: []*Type1{c}}}})
+	}
+
+	return group
+}
+
+func (g *generator) comment(r *Rule, name string) {
+	fmt.Fprintf(&g.buf, "// %s is generated from %s.\n", name, r.Name)
+}
+
+func (g *generator) emitEnum(r *Rule, name string, choices []*Type1) {
+	g.comment(r, name)
+	fmt.Fprintf(&g.buf, "type %s string\n\nconst (\n", name)
+
+	for _, c := range choices {
+		fmt.Fprintf(&g.buf, "\t%s%s %s = %q\n", name, constName(c.Value), name, c.Value)
+	}
+
+	fmt.Fprintf(&g.buf, ")\n\n")
+}
+
+type field struct {
+	key      string
+	typ      *Type
+	optional bool
+}
+
+func (g *generator) emitStruct(buf *bytes.Buffer, r *Rule, name string, group *Group) error {
+	fields := g.fields(group, false, map[string]bool{})
+
+	fmt.Fprintf(buf, "// %s is generated from %s.\n", name, r.Name)
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+
+	for _, f := range fields {
+		fieldName := goName(f.key)
+		goType := g.fieldType(f.typ, name+fieldName)
+		nullable := len(withoutNull(f.typ)) != len(f.typ.Choices)
+		tag := f.key
+
+		if f.optional {
+			tag += ",omitempty"
+		}
+
+		if (f.optional && g.needsPointer(goType)) || (nullable && !g.isReference(goType)) {
+			goType = "*" + goType
+		}
+
+		fmt.Fprintf(buf, "\t%s %s `json:%q`\n", fieldName, goType, tag)
+	}
+
+	fmt.Fprintf(buf, "}\n\n")
+
+	return nil
+}
+
+// fields flattens a group into struct fields. Group references are inlined and the fields of
+// group choices are merged, with fields missing from some choices becoming optional.
+func (g *generator) fields(group *Group, optional bool, seen map[string]bool) []field {
+	var (
+		merged []field
+		index  = map[string]int{}
+		counts = map[string]int{}
+	)
+
+	for _, entries := range group.Choices {
+		var choice []field
+
+		for _, e := range entries {
+			choice = append(choice, g.entryFields(e, optional, seen)...)
+		}
+
+		for _, f := range choice {
+			counts[f.key]++
+
+			if i, ok := index[f.key]; ok {
+				// Keep the first definition, but widen literal types shared across choices.
+				if !sameType(merged[i].typ, f.typ) {
+					merged[i].typ = &Type{Choices: append(append([]*Type1{}, merged[i].typ.Choices...), f.typ.Choices...)}
+				}
+
+				merged[i].optional = merged[i].optional || f.optional
+
+				continue
+			}
+
+			index[f.key] = len(merged)
+			merged = append(merged, f)
+		}
+	}
+
+	if len(group.Choices) > 1 {
+		for i := range merged {
+			if counts[merged[i].key] < len(group.Choices) {
+				merged[i].optional = true
+			}
+		}
+	}
+
+	return merged
+}
+
+func (g *generator) entryFields(e *Entry, optional bool, seen map[string]bool) []field {
+	optional = optional || e.Optional()
+
+	if e.Key != "" {
+		return []field{{key: e.Key, typ: e.Type, optional: optional}}
+	}
+
+	// Wildcard entries such as Extensible are not mapped to fields.
+	if e.KeyType != nil {
+		return nil
+	}
+
+	var fields []field
+
+	for _, c := range e.Type.Choices {
+		switch c.Kind {
+		case kindName:
+			r, ok := g.rules[c.Value]
+			if !ok || seen[c.Value] {
+				continue
+			}
+
+			seen[c.Value] = true
+
+			choices := withoutNull(r.Type)
+			if len(choices) == 1 && choices[0].Kind == kindGroup {
+				if inner, ok := unwrap(choices[0].Group); ok {
+					choices = withoutNull(inner)
+				}
+			}
+
+			if len(choices) > 1 {
+				fields = append(fields, g.fields(alternatives(choices), optional, seen)...)
+			}
+
+			for _, rc := range choices {
+				if len(choices) == 1 && (rc.Kind == kindGroup || rc.Kind == kindMap) {
+					fields = append(fields, g.fields(rc.Group, optional, seen)...)
+				}
+			}
+
+			delete(seen, c.Value)
+		case kindGroup:
+			fields = append(fields, g.fields(c.Group, optional, seen)...)
+		}
+	}
+
+	return fields
+}
+
+func (g *generator) fieldType(t *Type, inlineName string) string {
+	choices := withoutNull(t)
+
+	if stringLike(choices) {
+		return "string"
+	}
+
+	if len(choices) != 1 {
+		if allNumeric(choices) {
+			return "float64"
+		}
+
+		return "json.RawMessage"
+	}
+
+	c := choices[0]
+
+	switch c.Kind {
+	case kindName:
+		if p, ok := primitives[c.Value]; ok {
+			return p.goType
+		}
+
+		if _, ok := g.rules[c.Value]; ok {
+			return goName(c.Value)
+		}
+
+		return "json.RawMessage"
+	case kindNumber, kindRange:
+		if isFloat(c) {
+			return "float64"
+		}
+
+		return "int64"
+	case kindMap:
+		if value, ok := mapValue(c.Group); ok {
+			return "map[string]" + g.fieldType(value, inlineName+"Value")
+		}
+
+		_ = g.emitStruct(&g.inline, &Rule{Name: "an inline map"}, inlineName, c.Group)
+		g.types[inlineName] = categoryStruct
+
+		return inlineName
+	case kindArray:
+		if len(c.Group.Choices) == 1 && len(c.Group.Choices[0]) == 1 {
+			return "[]" + g.fieldType(c.Group.Choices[0][0].Type, inlineName+"Item")
+		}
+
+		return "[]json.RawMessage"
+	case kindGroup:
+		if inner, ok := unwrap(c.Group); ok {
+			return g.fieldType(inner, inlineName)
+		}
+	}
+
+	return "json.RawMessage"
+}
+
+func (g *generator) needsPointer(goType string) bool {
+	switch goType {
+	case "bool", "int64", "float64":
+		return true
+	case "string":
+		return false
+	}
+
+	cat, ok := g.types[goType]
+
+	return ok && cat != categoryString && cat != categoryReference
+}
+
+func (g *generator) isReference(goType string) bool {
+	if strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") || goType == "json.RawMessage" || goType == "interface{}" {
+		return true
+	}
+
+	return g.types[goType] == categoryReference
+}
+
+func isFloat(c *Type1) bool {
+	if c.Kind == kindRange {
+		return c.Float
+	}
+
+	return strings.Contains(c.Value, ".")
+}
+
+func withoutNull(t *Type) []*Type1 {
+	choices := make([]*Type1, 0, len(t.Choices))
+
+	for _, c := range t.Choices {
+		if c.Kind == kindName && c.Value == "null" {
+			continue
+		}
+
+		choices = append(choices, c)
+	}
+
+	return choices
+}
+
+func allStrings(choices []*Type1) bool {
+	for _, c := range choices {
+		if c.Kind != kindString {
+			return false
+		}
+	}
+
+	return len(choices) > 0
+}
+
+// stringLike reports whether every choice is a string literal or text, such as text / "console".
+func stringLike(choices []*Type1) bool {
+	for _, c := range choices {
+		if c.Kind != kindString && !(c.Kind == kindName && primitives[c.Value].goType == "string") {
+			return false
+		}
+	}
+
+	return len(choices) > 0
+}
+
+func allNumeric(choices []*Type1) bool {
+	for _, c := range choices {
+		isNumber := c.Kind == kindNumber || c.Kind == kindRange
+		if !isNumber && !(c.Kind == kindName && (primitives[c.Value].category == categoryInt || primitives[c.Value].category == categoryFloat)) {
+			return false
+		}
+	}
+
+	return len(choices) > 0
+}
+
+// unwrap returns the type of a parenthesized type such as (js-uint .ge 1).
+func unwrap(group *Group) (*Type, bool) {
+	if len(group.Choices) != 1 || len(group.Choices[0]) != 1 {
+		return nil, false
+	}
+
+	e := group.Choices[0][0]
+	if e.Key != "" || e.KeyType != nil || e.Occur != "" {
+		return nil, false
+	}
+
+	for _, c := range e.Type.Choices {
+		if c.Kind == kindGroup {
+			return nil, false
+		}
+	}
+
+	return e.Type, true
+}
+
+// mapValue returns the value type of a map with only a wildcard entry such as {*text => text}.
+func mapValue(group *Group) (*Type, bool) {
+	if len(group.Choices) != 1 || len(group.Choices[0]) != 1 {
+		return nil, false
+	}
+
+	e := group.Choices[0][0]
+	if e.KeyType == nil {
+		return nil, false
+	}
+
+	return e.Type, true
+}
+
+func hasKeys(group *Group) bool {
+	for _, entries := range group.Choices {
+		for _, e := range entries {
+			if e.Key != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func sameType(a, b *Type) bool {
+	if len(a.Choices) != len(b.Choices) {
+		return false
+	}
+
+	for i := range a.Choices {
+		if a.Choices[i].Kind != b.Choices[i].Kind || a.Choices[i].Value != b.Choices[i].Value || a.Choices[i].Group != b.Choices[i].Group {
+			return false
+		}
+	}
+
+	return true
+}
+
+// goName converts a CDDL name such as browsingContext.CreateParameters into BrowsingContextCreateParameters.
+func goName(name string) string {
+	var sb strings.Builder
+
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '-' || r == '_' }) {
+		for _, word := range splitWords(part) {
+			if initialisms[strings.ToUpper(word)] {
+				sb.WriteString(strings.ToUpper(word))
+				continue
+			}
+
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			sb.WriteString(string(runes))
+		}
+	}
+
+	return sb.String()
+}
+
+// splitWords splits camel case at lower to upper case transitions.
+func splitWords(s string) []string {
+	var (
+		words []string
+		start int
+		runes = []rune(s)
+	)
+
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+// constName converts an enum literal such as "dedicated-worker" or "-Infinity" into a Go identifier.
+func constName(literal string) string {
+	if strings.HasPrefix(literal, "-") {
+		literal = "minus " + literal[1:]
+	}
+
+	parts := strings.FieldsFunc(literal, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return goName(strings.Join(parts, "."))
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRemote = `
+Extensible = (*text => any)
+
+browsingContext.BrowsingContext = text;
+
+browsingContext.ReadinessState = "none" / "interactive" / "complete"
+
+browsingContext.Navigate = (
+  method: "browsingContext.navigate",
+  params: browsingContext.NavigateParameters
+)
+
+browsingContext.NavigateParameters = {
+  context: browsingContext.BrowsingContext,
+  url: text,
+  ? wait: browsingContext.ReadinessState,
+  ? maxDepth: (js-uint .ge 1),
+}
+
+script.Target = (
+  script.ContextTarget /
+  script.RealmTarget
+)
+
+script.ContextTarget = {
+  context: browsingContext.BrowsingContext,
+  ? sandbox: text
+}
+
+script.RealmTarget = {
+  realm: text
+}
+`
+
+const testLocal = `
+browsingContext.NavigateResult = {
+  navigation: text / null,
+  url: text,
+  Extensible
+}
+
+browsingContext.Load = (
+  method: "browsingContext.load",
+  params: browsingContext.NavigateResult
+)
+`
+
+func TestGenerate(t *testing.T) {
+	remote, err := Parse(testRemote, "remote")
+	assert.NoError(t, err)
+
+	local, err := Parse(testLocal, "local")
+	assert.NoError(t, err)
+
+	src, err := Generate("protocol", append(remote, local...))
+	assert.NoError(t, err)
+
+	code := string(src)
+	assert.Contains(t, code, `CommandBrowsingContextNavigate = "browsingContext.navigate"`)
+	assert.Contains(t, code, `EventBrowsingContextLoad = "browsingContext.load"`)
+	assert.Contains(t, code, "type BrowsingContextBrowsingContext string")
+	assert.Contains(t, code, `BrowsingContextReadinessStateInteractive BrowsingContextReadinessState = "interactive"`)
+	assert.Regexp(t, `Context\s+BrowsingContextBrowsingContext\s+`+"`"+`json:"context"`+"`", code)
+	assert.Regexp(t, `Wait\s+BrowsingContextReadinessState\s+`+"`"+`json:"wait,omitempty"`+"`", code)
+	assert.Regexp(t, `MaxDepth\s+\*int64\s+`+"`"+`json:"maxDepth,omitempty"`+"`", code)
+	assert.Regexp(t, `Navigation\s+\*string\s+`+"`"+`json:"navigation"`+"`", code)
+	assert.Regexp(t, `Realm\s+string\s+`+"`"+`json:"realm,omitempty"`+"`", code)
+}
+
+func TestGoName(t *testing.T) {
+	assert.Equal(t, "BrowsingContextCreateParameters", goName("browsingContext.CreateParameters"))
+	assert.Equal(t, "SessionID", goName("sessionId"))
+	assert.Equal(t, "WebSocketURL", goName("webSocketUrl"))
+	assert.Equal(t, "DedicatedWorker", constName("dedicated-worker"))
+	assert.Equal(t, "MinusInfinity", constName("-Infinity"))
+}
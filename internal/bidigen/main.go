@@ -0,0 +1,66 @@
+// Command bidigen generates Go bindings for the WebDriver BiDi protocol from the CDDL
+// definitions of the specification.
+//
+// Usage:
+//
+//	bidigen -remote remote.cddl -local local.cddl -pkg protocol -out protocol.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	var (
+		remote = flag.String("remote", "", "CDDL file with the remote end definition (commands)")
+		local  = flag.String("local", "", "CDDL file with the local end definition (results and events)")
+		pkg    = flag.String("pkg", "protocol", "package name of the generated code")
+		out    = flag.String("out", "", "output file, defaults to stdout")
+	)
+
+	flag.Parse()
+
+	if err := run(*remote, *local, *pkg, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "bidigen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(remote, local, pkg, out string) error {
+	var rules []*Rule
+
+	for source, path := range map[string]string{"remote": remote, "local": local} {
+		if path == "" {
+			return fmt.Errorf("missing -%s", source)
+		}
+	}
+
+	// The remote end is parsed first so that its definitions take precedence.
+	for _, input := range []struct{ source, path string }{{"remote", remote}, {"local", local}} {
+		data, err := os.ReadFile(input.path)
+		if err != nil {
+			return err
+		}
+
+		parsed, err := Parse(string(data), input.source)
+		if err != nil {
+			return fmt.Errorf("%s: %w", input.path, err)
+		}
+
+		rules = append(rules, parsed...)
+	}
+
+	src, err := Generate(pkg, rules)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+
+	return os.WriteFile(out, src, 0644) // nolint gosec
+}
@@ -0,0 +1,125 @@
+package webdriver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// DriverManager starts drivers in parallel and stops them together.
+type DriverManager struct {
+	mu      sync.Mutex
+	drivers []WebDriver
+}
+
+func NewDriverManager() *DriverManager {
+	return &DriverManager{}
+}
+
+// Start creates n drivers with newDriver and starts them in parallel. Drivers should use an
+// automatically chosen port, so that they are restarted on another port if two drivers pick the
+// same one. If a driver fails to start, the drivers started by this call are stopped again.
+func (m *DriverManager) Start(n int, newDriver func() (WebDriver, error)) ([]WebDriver, error) {
+	drivers := make([]WebDriver, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			d, err := newDriver()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			if err := d.Start(); err != nil {
+				errs[i] = err
+				return
+			}
+
+			drivers[i] = d
+		}(i)
+	}
+
+	wg.Wait()
+
+	if err := joinErrors(errs); err != nil {
+		for _, d := range drivers {
+			if d != nil {
+				_ = d.Stop()
+			}
+		}
+
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.drivers = append(m.drivers, drivers...)
+	m.mu.Unlock()
+
+	return drivers, nil
+}
+
+// Add adds a started driver to the drivers stopped by StopAll.
+func (m *DriverManager) Add(d WebDriver) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.drivers = append(m.drivers, d)
+}
+
+// Drivers returns the managed drivers.
+func (m *DriverManager) Drivers() []WebDriver {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]WebDriver(nil), m.drivers...)
+}
+
+// StopAll stops all managed drivers in parallel.
+func (m *DriverManager) StopAll() error {
+	m.mu.Lock()
+	drivers := m.drivers
+	m.drivers = nil
+	m.mu.Unlock()
+
+	errs := make([]error, len(drivers))
+
+	var wg sync.WaitGroup
+
+	for i, d := range drivers {
+		wg.Add(1)
+
+		go func(i int, d WebDriver) {
+			defer wg.Done()
+
+			errs[i] = d.Stop()
+		}(i, d)
+	}
+
+	wg.Wait()
+
+	return joinErrors(errs)
+}
+
+func joinErrors(errs []error) error {
+	var msgs []string
+
+	for i, err := range errs {
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("driver %d: %s", i, err))
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(msgs, "\n"))
+}
@@ -0,0 +1,64 @@
+package webdriver
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDriver struct {
+	WebDriver
+	started, stopped bool
+	err              error
+}
+
+func (d *fakeDriver) Start() error {
+	d.started = true
+	return d.err
+}
+
+func (d *fakeDriver) Stop() error {
+	d.stopped = true
+	return nil
+}
+
+func TestDriverManager(t *testing.T) {
+	m := NewDriverManager()
+
+	drivers, err := m.Start(3, func() (WebDriver, error) {
+		return &fakeDriver{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, drivers, 3)
+	assert.Len(t, m.Drivers(), 3)
+
+	assert.NoError(t, m.StopAll())
+	assert.Empty(t, m.Drivers())
+
+	for _, d := range drivers {
+		assert.True(t, d.(*fakeDriver).stopped)
+	}
+
+	var calls int32
+
+	_, err = m.Start(2, func() (WebDriver, error) {
+		d := &fakeDriver{}
+		if atomic.AddInt32(&calls, 1) == 2 {
+			d.err = errors.New("boot failed")
+		}
+
+		return d, nil
+	})
+	assert.ErrorContains(t, err, "boot failed")
+	assert.Empty(t, m.Drivers())
+}
+
+func TestIsAddressInUse(t *testing.T) {
+	assert.True(t, isAddressInUse(&ServiceError{
+		Err:    errors.New("driver exited during boot: exit status 1"),
+		Output: []string{"bind() failed: Address already in use (98)", "IPv4 port not available. Exiting..."},
+	}))
+	assert.False(t, isAddressInUse(errors.New("failed to start before timeout")))
+}
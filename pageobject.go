@@ -0,0 +1,317 @@
+package webdriver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	elementType  = reflect.TypeOf(Element{})
+	elementsType = reflect.TypeOf(Elements{})
+)
+
+// Bind fills the fields of the page object pointed to by page with the elements located by their wd
+// struct tags, starting from sc. sc is a Session, an Element or a ShadowRoot.
+//
+//	type LoginPage struct {
+//		Username *webdriver.Element  `wd:"id=username"`
+//		Buttons  *webdriver.Elements `wd:"css=button"`
+//		Tabs     []webdriver.Element `wd:"css=[role=tab]"`
+//		Footer   Footer              `wd:"css=footer"`
+//		Widget   Widget              `wd:"css=my-widget" scope:"shadow"`
+//	}
+//
+// A tag is a locator strategy and a selector separated by "=". The strategies are css, xpath, tag,
+// link, partial-link, id, name, class, testid and text.
+//
+// *Element and *Elements fields are found on each access, so they survive page reloads and
+// re-renders. []Element fields are the exception, as the length of a slice is fixed: Bind locates
+// their elements once, and each element is found again by its index on access. Use *Elements for
+// lists whose length changes.
+//
+// Struct fields and pointers to structs are components whose fields are bound to the element
+// located by the tag of the component, or to the scope of the parent without a tag. Nil pointers
+// to components are only allocated for tagged fields. A scope:"shadow" tag binds components to the
+// shadow root of the element instead. Lists of components are bound with Elements.At, like
+// Bind(list.At(i), &item).
+func Bind(sc SearchContext, page interface{}) error {
+	v := reflect.ValueOf(page)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("page must be a non-nil pointer to a struct")
+	}
+
+	return bindStruct(sc, v.Elem())
+}
+
+func bindStruct(sc SearchContext, v reflect.Value) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+
+		if err := bindField(sc, field, v.Field(i)); err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func bindField(sc SearchContext, field reflect.StructField, v reflect.Value) error {
+	tag, tagged := field.Tag.Lookup("wd")
+
+	var by By
+
+	if tagged {
+		var err error
+		if by, err = parseLocatorTag(tag); err != nil {
+			return err
+		}
+	}
+
+	shadow := field.Tag.Get("scope") == "shadow"
+
+	switch {
+	case field.Type == reflect.PtrTo(elementType):
+		if by == nil {
+			return nil
+		}
+
+		element, err := boundElement(sc, by)
+		if err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(element))
+	case field.Type == reflect.PtrTo(elementsType):
+		if by == nil {
+			return nil
+		}
+
+		sessionID, client, err := sessionOf(sc)
+		if err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(&Elements{sessionID: sessionID, client: client, sc: sc, by: by}))
+	case field.Type.Kind() == reflect.Struct:
+		return bindComponent(sc, by, shadow, v)
+	case field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct:
+		if v.IsNil() {
+			if by == nil {
+				return nil
+			}
+
+			v.Set(reflect.New(field.Type.Elem()))
+		}
+
+		return bindComponent(sc, by, shadow, v.Elem())
+	case field.Type == reflect.SliceOf(elementType):
+		if by == nil {
+			return nil
+		}
+
+		elements, err := boundElementSlice(sc, by)
+		if err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(elements))
+	case tagged:
+		return fmt.Errorf("unsupported field type %s", field.Type)
+	}
+
+	return nil
+}
+
+func bindComponent(sc SearchContext, by By, shadow bool, v reflect.Value) error {
+	if by == nil {
+		if shadow {
+			return errors.New("shadow scope requires a wd tag")
+		}
+
+		return bindStruct(sc, v)
+	}
+
+	element, err := boundElement(sc, by)
+	if err != nil {
+		return err
+	}
+
+	return bindStruct(componentScope(element, shadow), v)
+}
+
+func componentScope(element *Element, shadow bool) SearchContext {
+	if shadow {
+		return &shadowScope{host: element}
+	}
+
+	return element
+}
+
+// Elements is a list of elements bound to a locator by Bind. The elements are found again on each
+// call.
+type Elements struct {
+	sessionID string
+	client    *RestClient
+	sc        SearchContext
+	by        By
+}
+
+// All returns the elements that are currently located.
+func (l *Elements) All() ([]Element, error) {
+	if l == nil || l.by == nil {
+		return nil, errors.New("elements not bound")
+	}
+
+	return l.by.FindElements(l.sc)
+}
+
+// Len returns the number of elements that are currently located.
+func (l *Elements) Len() (int, error) {
+	elements, err := l.All()
+	return len(elements), err
+}
+
+// At returns the element at index i of the list. It is found again before each command and can be
+// the scope of a component.
+func (l *Elements) At(i int) *Element {
+	element := &Element{
+		locate: newLocator(func() (*Element, error) {
+			elements, err := l.All()
+			if err != nil {
+				return nil, err
+			}
+
+			if i < 0 || i >= len(elements) {
+				return nil, fmt.Errorf("%w: index %d of %d elements", ErrNoSuchElement, i, len(elements))
+			}
+
+			return &elements[i], nil
+		}),
+	}
+
+	if l != nil {
+		element.SessionID = l.sessionID
+		element.client = l.client
+	}
+
+	return element
+}
+
+// boundElementSlice returns the elements currently located by by, each found again by its index
+// before each command.
+func boundElementSlice(sc SearchContext, by By) ([]Element, error) {
+	sessionID, client, err := sessionOf(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &Elements{sessionID: sessionID, client: client, sc: sc, by: by}
+
+	n, err := list.Len()
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]Element, n)
+	for i := range elements {
+		elements[i] = *list.At(i)
+	}
+
+	return elements, nil
+}
+
+// boundElement returns an element that is found with by before each command.
+func boundElement(sc SearchContext, by By) (*Element, error) {
+	sessionID, client, err := sessionOf(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Element{
+		SessionID: sessionID,
+		client:    client,
+		locate: newLocator(func() (*Element, error) {
+			return findElementBy(sc, by)
+		}),
+	}, nil
+}
+
+func sessionOf(sc SearchContext) (string, *RestClient, error) {
+	switch sc := sc.(type) {
+	case *Session:
+		return sc.ID, sc.client, nil
+	case *Element:
+		return sc.SessionID, sc.client, nil
+	case *ShadowRoot:
+		return sc.SessionID, sc.client, nil
+	case *shadowScope:
+		return sc.host.SessionID, sc.host.client, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported search context %T", sc)
+	}
+}
+
+// shadowScope searches the shadow root of its host, which is looked up on each search.
+type shadowScope struct {
+	host *Element
+}
+
+func (s *shadowScope) FindElement(strategy LocatorStrategy, selector string) (*Element, error) {
+	shadowRoot, err := s.host.GetShadowRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return shadowRoot.FindElement(strategy, selector)
+}
+
+func (s *shadowScope) FindElements(strategy LocatorStrategy, selector string) ([]Element, error) {
+	shadowRoot, err := s.host.GetShadowRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return shadowRoot.FindElements(strategy, selector)
+}
+
+// parseLocatorTag parses a wd struct tag like "css=#login".
+func parseLocatorTag(tag string) (By, error) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid wd tag %q", tag)
+	}
+
+	selector := parts[1]
+
+	switch strings.TrimSpace(parts[0]) {
+	case "css":
+		return ByCSSSelector(selector), nil
+	case "xpath":
+		return ByXPath(selector), nil
+	case "tag":
+		return ByTagName(selector), nil
+	case "link":
+		return ByLinkText(selector), nil
+	case "partial-link":
+		return ByPartialLinkText(selector), nil
+	case "id":
+		return ByID(selector), nil
+	case "name":
+		return ByName(selector), nil
+	case "class":
+		return ByClassName(selector), nil
+	case "testid":
+		return ByTestID(selector), nil
+	case "text":
+		return ByText(selector), nil
+	default:
+		return nil, fmt.Errorf("unknown locator strategy in wd tag %q", tag)
+	}
+}
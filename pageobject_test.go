@@ -0,0 +1,135 @@
+package webdriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type footer struct {
+	Copyright *Element `wd:"css=.copy"`
+}
+
+type widget struct {
+	Inner *Element `wd:"css=span"`
+}
+
+type item struct {
+	Link *Element `wd:"tag=a"`
+}
+
+type loginPage struct {
+	Username *Element  `wd:"id=username"`
+	Buttons  *Elements `wd:"css=button"`
+	Footer   footer    `wd:"css=footer"`
+	Widget   *widget   `wd:"css=my-widget" scope:"shadow"`
+	Items    *Elements `wd:"css=li"`
+	Title    string
+}
+
+func TestBind(t *testing.T) {
+	finds := 0
+
+	remote := newTestRemote(t, func(cmd *testCommand) interface{} {
+		switch path, value := cmd.Path, cmd.param("value"); {
+		case path == "/elements" && value == `[id="username"]`:
+			finds++
+			return []map[string]string{elementValue(fmt.Sprintf("username-%d", finds))}
+		case path == "/elements" && value == "footer":
+			return []map[string]string{elementValue("footer")}
+		case path == "/elements" && value == "my-widget":
+			return []map[string]string{elementValue("widget")}
+		case path == "/elements" && value == "button":
+			return []map[string]string{elementValue("b1"), elementValue("b2")}
+		case path == "/elements" && value == "li":
+			return []map[string]string{elementValue("li1"), elementValue("li2")}
+		case path == "/element/footer/elements":
+			return []map[string]string{elementValue("copy")}
+		case path == "/element/widget/shadow":
+			return map[string]string{"shadow-6066-11e4-a52e-4f735466cecf": "root"}
+		case path == "/shadow/root/elements":
+			return []map[string]string{elementValue("inner")}
+		case strings.HasSuffix(path, "/elements") && value == "a":
+			return []map[string]string{elementValue("a-" + strings.Split(path, "/")[2])}
+		case strings.HasSuffix(path, "/text"):
+			return strings.Split(path, "/")[2]
+		}
+
+		return nil
+	})
+
+	session := remote.session("s1")
+
+	page := loginPage{}
+	assert.NoError(t, Bind(session, &page))
+	assert.Empty(t, remote.commands())
+
+	text := func(e *Element) string {
+		text, err := e.GetText()
+		assert.NoError(t, err)
+
+		return text
+	}
+
+	// Elements are found again on each access
+	assert.Equal(t, "username-1", text(page.Username))
+	assert.Equal(t, "username-2", text(page.Username))
+
+	buttons, err := page.Buttons.All()
+	assert.NoError(t, err)
+	assert.Len(t, buttons, 2)
+
+	assert.Equal(t, "copy", text(page.Footer.Copyright))
+	assert.Equal(t, "inner", text(page.Widget.Inner))
+	items, err := page.Items.Len()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, items)
+
+	second := item{}
+	assert.NoError(t, Bind(page.Items.At(1), &second))
+	assert.Equal(t, "a-li2", text(second.Link))
+
+	_, err = page.Items.At(2).GetText()
+	assert.ErrorIs(t, err, ErrNoSuchElement)
+
+	// Bound elements are found before they are serialized
+	data, err := json.Marshal([]interface{}{page.Username})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"element-6066-11e4-a52e-4f735466cecf":"username-3"}]`, string(data))
+
+	// Bound elements can be used concurrently
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := page.Username.GetText()
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Error(t, Bind(session, page))
+	assert.Error(t, Bind(session, &struct {
+		Field *Element `wd:"unknown=x"`
+	}{}))
+	assert.Error(t, Bind(session, &struct {
+		Field []string `wd:"css=a"`
+	}{}))
+
+	// Slices are located once and their elements are found again by index
+	rows := struct {
+		Items []Element `wd:"css=li"`
+	}{}
+	assert.NoError(t, Bind(session, &rows))
+	assert.Len(t, rows.Items, 2)
+	assert.Equal(t, "li2", text(&rows.Items[1]))
+}
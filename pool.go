@@ -0,0 +1,327 @@
+package webdriver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ResetStep cleans up a session before it is handed to the next borrower.
+type ResetStep func(s *Session) error
+
+// ResetCloseExtraWindows closes all windows but the first one and switches to it.
+func ResetCloseExtraWindows(s *Session) error {
+	handles, err := s.GetWindowHandles()
+	if err != nil {
+		return err
+	}
+
+	if len(handles) == 0 {
+		return errors.New("session has no windows")
+	}
+
+	for _, handle := range handles[1:] {
+		if err := s.SwitchToWindow(handle); err != nil {
+			return err
+		}
+
+		if err := s.CloseWindow(); err != nil {
+			return err
+		}
+	}
+
+	return s.SwitchToWindow(handles[0])
+}
+
+// ResetClearStorage clears the local and session storage of the current document.
+func ResetClearStorage(s *Session) error {
+	_, err := s.ExecuteScript(`try { window.localStorage.clear(); window.sessionStorage.clear(); } catch (e) {}`, []interface{}{})
+	return err
+}
+
+// ResetDeleteCookies deletes the cookies of the current document.
+func ResetDeleteCookies(s *Session) error {
+	return s.DeleteCookies()
+}
+
+// ResetNavigateToBlank navigates to about:blank.
+func ResetNavigateToBlank(s *Session) error {
+	return s.NavigateTo("about:blank")
+}
+
+// DefaultResetSteps are the reset steps of a SessionPool. Storage and cookies are cleared before
+// navigating away, as both are bound to the current document.
+var DefaultResetSteps = []ResetStep{
+	ResetCloseExtraWindows,
+	ResetClearStorage,
+	ResetDeleteCookies,
+	ResetNavigateToBlank,
+}
+
+type SessionPoolOptions struct {
+	// MinSize is the number of sessions created up front and kept alive after evictions
+	MinSize int
+
+	// MaxSize is the maximum number of sessions. Defaults to 4.
+	MaxSize int
+
+	// Session configures the sessions of the pool
+	Session []func(o *SessionOptions)
+
+	// ResetSteps run when a session is returned. Defaults to DefaultResetSteps.
+	ResetSteps []ResetStep
+
+	// HealthCheck runs before a session is borrowed. Defaults to a check of the driver status and
+	// the current window of the session.
+	HealthCheck func(s *Session) error
+}
+
+// SessionPool reuses sessions of a driver. Sessions are reset between borrowers, and sessions that
+// fail a health check or a reset step are closed and replaced.
+type SessionPool struct {
+	driver   WebDriver
+	opts     SessionPoolOptions
+	tokens   chan struct{} // one token per borrowed session
+	mu       sync.Mutex
+	idle     []*Session
+	borrowed map[*Session]bool
+	inUse    int
+	closed   bool
+}
+
+func NewSessionPool(driver WebDriver, optFns ...func(o *SessionPoolOptions)) (*SessionPool, error) {
+	opts := SessionPoolOptions{
+		MinSize:    0,
+		MaxSize:    4, // nolint gomnd
+		ResetSteps: DefaultResetSteps,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	if opts.HealthCheck == nil {
+		opts.HealthCheck = func(s *Session) error {
+			return checkSessionHealth(driver, s)
+		}
+	}
+
+	if opts.MaxSize <= 0 {
+		return nil, errors.New("max size must be positive")
+	}
+
+	if opts.MinSize < 0 || opts.MinSize > opts.MaxSize {
+		return nil, fmt.Errorf("min size must be between 0 and %d", opts.MaxSize)
+	}
+
+	p := &SessionPool{
+		driver:   driver,
+		opts:     opts,
+		tokens:   make(chan struct{}, opts.MaxSize),
+		borrowed: map[*Session]bool{},
+	}
+
+	for i := 0; i < opts.MinSize; i++ {
+		s, err := p.newSession()
+		if err != nil {
+			_ = p.Close()
+			return nil, err
+		}
+
+		p.idle = append(p.idle, s)
+	}
+
+	return p, nil
+}
+
+// Get borrows a session. It waits for a session to be returned if all sessions are in use.
+func (p *SessionPool) Get(ctx context.Context) (*Session, error) {
+	select {
+	case p.tokens <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for {
+		p.mu.Lock()
+
+		if p.closed {
+			p.mu.Unlock()
+			<-p.tokens
+
+			return nil, errors.New("session pool closed")
+		}
+
+		p.inUse++
+
+		if len(p.idle) == 0 {
+			p.mu.Unlock()
+			break
+		}
+
+		s := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		p.mu.Unlock()
+
+		if err := p.opts.HealthCheck(s); err == nil {
+			p.borrow(s)
+			return s, nil
+		}
+
+		p.discard(s)
+
+		if err := ctx.Err(); err != nil {
+			<-p.tokens
+			return nil, err
+		}
+	}
+
+	s, err := p.newSession()
+	if err != nil {
+		p.release()
+		return nil, err
+	}
+
+	p.borrow(s)
+
+	return s, nil
+}
+
+// Put returns a borrowed session to the pool. The session is reset first. If a reset step fails,
+// the session is closed and replaced, and the error of the step is returned. Sessions that are not
+// borrowed from the pool are rejected.
+func (p *SessionPool) Put(s *Session) error {
+	p.mu.Lock()
+
+	if !p.borrowed[s] {
+		p.mu.Unlock()
+		return errors.New("session not borrowed from this pool")
+	}
+
+	delete(p.borrowed, s)
+	closed := p.closed
+	p.mu.Unlock()
+
+	if closed {
+		p.discard(s)
+		<-p.tokens
+
+		return nil
+	}
+
+	for _, step := range p.opts.ResetSteps {
+		if err := step(s); err != nil {
+			p.discard(s)
+			<-p.tokens
+			p.replenish()
+
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	p.inUse--
+
+	if p.closed {
+		p.mu.Unlock()
+		<-p.tokens
+
+		return s.Close()
+	}
+
+	p.idle = append(p.idle, s)
+	p.mu.Unlock()
+
+	<-p.tokens
+
+	return nil
+}
+
+// Size returns the number of idle and borrowed sessions.
+func (p *SessionPool) Size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.idle) + p.inUse
+}
+
+// Close closes the idle sessions. Borrowed sessions are closed when they are returned.
+func (p *SessionPool) Close() error {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	var firstErr error
+
+	for _, s := range idle {
+		if err := s.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func (p *SessionPool) newSession() (*Session, error) {
+	return p.driver.NewSession(p.opts.Session...)
+}
+
+func (p *SessionPool) borrow(s *Session) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.borrowed[s] = true
+}
+
+// discard closes a borrowed session. The caller keeps its token.
+func (p *SessionPool) discard(s *Session) {
+	_ = s.Close()
+
+	p.mu.Lock()
+	p.inUse--
+	p.mu.Unlock()
+}
+
+// replenish creates idle sessions until the pool has its minimum size.
+func (p *SessionPool) replenish() {
+	for {
+		p.mu.Lock()
+		missing := !p.closed && len(p.idle)+p.inUse < p.opts.MinSize
+		p.mu.Unlock()
+
+		if !missing {
+			return
+		}
+
+		s, err := p.newSession()
+		if err != nil {
+			return
+		}
+
+		p.mu.Lock()
+		p.idle = append(p.idle, s)
+		p.mu.Unlock()
+	}
+}
+
+// release gives up a borrowed slot.
+func (p *SessionPool) release() {
+	p.mu.Lock()
+	p.inUse--
+	p.mu.Unlock()
+
+	<-p.tokens
+}
+
+func checkSessionHealth(driver WebDriver, s *Session) error {
+	if _, err := driver.Status(); err != nil {
+		return err
+	}
+
+	_, err := s.GetWindowHandle()
+
+	return err
+}
@@ -0,0 +1,140 @@
+package webdriver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// poolDriver is a fake driver whose sessions are served by a testRemote.
+type poolDriver struct {
+	WebDriver
+	*testRemote
+	mu     sync.Mutex
+	next   int
+	dead   map[string]bool
+	closed map[string]bool
+}
+
+func newPoolDriver(t *testing.T) *poolDriver {
+	d := &poolDriver{dead: map[string]bool{}, closed: map[string]bool{}}
+
+	d.testRemote = newTestRemote(t, func(cmd *testCommand) interface{} {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		switch {
+		case d.dead[cmd.SessionID]:
+			return map[string]string{"error": "invalid session id"}
+		case cmd.Path == "" && cmd.Method == http.MethodDelete:
+			d.closed[cmd.SessionID] = true
+		case cmd.Path == "/window/handles":
+			return []string{"w1", "w2"}
+		case cmd.Path == "/window" && cmd.Method == http.MethodGet:
+			return "w1"
+		}
+
+		return nil
+	})
+
+	return d
+}
+
+// calls returns the received commands as "<session> <method> <path>".
+func (d *poolDriver) calls() []string {
+	var calls []string
+
+	for _, cmd := range d.commands() {
+		calls = append(calls, fmt.Sprintf("%s %s %s", cmd.SessionID, cmd.Method, cmd.Path))
+	}
+
+	return calls
+}
+
+func (d *poolDriver) Status() (*Status, error) {
+	return &Status{Ready: true}, nil
+}
+
+func (d *poolDriver) NewSession(optFns ...func(o *SessionOptions)) (*Session, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.next++
+
+	return d.session(fmt.Sprintf("s%d", d.next)), nil
+}
+
+func TestSessionPool(t *testing.T) {
+	driver := newPoolDriver(t)
+
+	pool, err := NewSessionPool(driver, func(o *SessionPoolOptions) {
+		o.MinSize = 1
+		o.MaxSize = 2
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pool.Size())
+
+	s1, err := pool.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "s1", s1.ID)
+
+	s2, err := pool.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "s2", s2.ID)
+
+	// The pool is exhausted until a session is returned
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = pool.Get(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	driver.reset()
+
+	assert.NoError(t, pool.Put(s1))
+	assert.Equal(t, []string{
+		"s1 GET /window/handles",
+		"s1 POST /window",
+		"s1 DELETE /window",
+		"s1 POST /window",
+		"s1 POST /execute/sync",
+		"s1 DELETE /cookie",
+		"s1 POST /url",
+	}, driver.calls())
+
+	// An unhealthy session is evicted and replaced
+	driver.mu.Lock()
+	driver.dead["s1"] = true
+	driver.mu.Unlock()
+
+	s3, err := pool.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "s3", s3.ID)
+	assert.Equal(t, 2, pool.Size())
+
+	assert.NoError(t, pool.Put(s3))
+
+	// Sessions that are not borrowed are rejected
+	assert.Error(t, pool.Put(s3))
+	assert.Error(t, pool.Put(driver.session("s9")))
+	assert.Equal(t, 2, pool.Size())
+
+	// A session failing a reset step is evicted, and the pool is refilled to its minimum size
+	driver.mu.Lock()
+	driver.dead["s2"] = true
+	driver.mu.Unlock()
+
+	assert.Error(t, pool.Put(s2))
+	assert.Equal(t, 1, pool.Size())
+
+	assert.NoError(t, pool.Close())
+	assert.True(t, driver.closed["s3"])
+
+	_, err = pool.Get(context.Background())
+	assert.Error(t, err)
+}
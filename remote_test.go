@@ -0,0 +1,98 @@
+package webdriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testRemote is the driver side of sessions, served by an httptest server. It answers every
+// command with the value returned by handle and records the commands.
+type testRemote struct {
+	client *RestClient
+	handle func(cmd *testCommand) interface{}
+	mu     sync.Mutex
+	cmds   []testCommand
+}
+
+// testCommand is a command received by a testRemote.
+type testCommand struct {
+	Method    string
+	SessionID string
+	// Path below the session, for example "/elements". It is empty for the session itself.
+	Path   string
+	Body   string
+	Params map[string]interface{}
+}
+
+// newTestRemote returns a scripted remote end. A nil handle answers every command with a null value.
+func newTestRemote(t *testing.T, handle func(cmd *testCommand) interface{}) *testRemote {
+	remote := &testRemote{handle: handle}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		cmd := testCommand{Method: r.Method, Body: strings.TrimSpace(string(body)), Params: map[string]interface{}{}}
+		_ = json.Unmarshal(body, &cmd.Params)
+
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/session/"), "/", 2)
+		cmd.SessionID = parts[0]
+
+		if len(parts) == 2 {
+			cmd.Path = "/" + parts[1]
+		}
+
+		remote.mu.Lock()
+		remote.cmds = append(remote.cmds, cmd)
+		remote.mu.Unlock()
+
+		var value interface{}
+		if remote.handle != nil {
+			value = remote.handle(&cmd)
+		}
+
+		data, _ := json.Marshal(map[string]interface{}{"value": value})
+		fmt.Fprint(w, string(data))
+	}))
+	t.Cleanup(server.Close)
+
+	remote.client = NewRestClient(server.URL)
+
+	return remote
+}
+
+// session returns a session with the id that is served by the remote end.
+func (r *testRemote) session(id string) *Session {
+	return &Session{ID: id, client: r.client}
+}
+
+// commands returns the received commands in order.
+func (r *testRemote) commands() []testCommand {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]testCommand(nil), r.cmds...)
+}
+
+func (r *testRemote) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cmds = nil
+}
+
+// param returns a string parameter of the command.
+func (c *testCommand) param(name string) string {
+	s, _ := c.Params[name].(string)
+	return s
+}
+
+// elementValue is the JSON value of an element reference.
+func elementValue(id string) map[string]string {
+	return map[string]string{elementKey: id}
+}
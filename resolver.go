@@ -0,0 +1,549 @@
+package webdriver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/md5" // nolint gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Browser string
+
+const (
+	BrowserChrome Browser = "chrome"
+	BrowserEdge   Browser = "MicrosoftEdge"
+)
+
+const (
+	// DefaultChromeDriverMirrorURL hosts the Chrome for Testing builds of chromedriver.
+	DefaultChromeDriverMirrorURL = "https://storage.googleapis.com/chrome-for-testing-public"
+
+	// DefaultEdgeDriverMirrorURL hosts the builds of msedgedriver.
+	DefaultEdgeDriverMirrorURL = "https://msedgedriver.microsoft.com"
+)
+
+type DriverResolverOptions struct {
+	// Path of the browser binary. If empty, the browser is searched on PATH and in well-known locations.
+	BrowserPath string
+
+	// Version of the browser, for example 120.0.6099.109. If empty, it is read from the browser binary.
+	BrowserVersion string
+
+	// Directory downloaded drivers are cached in. Defaults to gowebdriver in the user cache directory.
+	CacheDir string
+
+	// Base URL drivers are downloaded from. Defaults to the official download location of the browser.
+	//
+	// Chrome drivers are expected at {MirrorURL}/{version}/{platform}/chromedriver-{platform}.zip and
+	// Edge drivers at {MirrorURL}/{version}/edgedriver_{platform}.zip. If no driver exists for the
+	// exact browser version, the version is read from {MirrorURL}/LATEST_RELEASE_{major}.
+	MirrorURL string
+
+	// Platform of the driver, for example linux64. Defaults to the platform of the running binary.
+	Platform string
+
+	// Offline only uses installed and cached drivers
+	Offline bool
+
+	// HTTPClient used for downloads
+	HTTPClient *http.Client
+}
+
+// DriverResolver finds a driver that matches the major version of the installed browser. Drivers
+// are looked up on PATH, in well-known locations and in the cache before one is downloaded.
+type DriverResolver struct {
+	browser Browser
+	opts    DriverResolverOptions
+}
+
+func NewDriverResolver(browser Browser, optFns ...func(o *DriverResolverOptions)) (*DriverResolver, error) {
+	opts := DriverResolverOptions{
+		HTTPClient: http.DefaultClient,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	switch browser {
+	case BrowserChrome:
+		if opts.MirrorURL == "" {
+			opts.MirrorURL = DefaultChromeDriverMirrorURL
+		}
+	case BrowserEdge:
+		if opts.MirrorURL == "" {
+			opts.MirrorURL = DefaultEdgeDriverMirrorURL
+		}
+	default:
+		return nil, fmt.Errorf("unsupported browser: %s", browser)
+	}
+
+	if opts.CacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.CacheDir = filepath.Join(dir, "gowebdriver")
+	}
+
+	if opts.Platform == "" {
+		opts.Platform = driverPlatform(browser)
+	}
+
+	return &DriverResolver{
+		browser: browser,
+		opts:    opts,
+	}, nil
+}
+
+var (
+	browserNames = map[Browser][]string{
+		BrowserChrome: {"google-chrome", "google-chrome-stable", "chromium", "chromium-browser", "chrome"},
+		BrowserEdge:   {"microsoft-edge", "microsoft-edge-stable", "msedge"},
+	}
+
+	browserLocations = map[Browser][]string{
+		BrowserChrome: {
+			"/opt/google/chrome/chrome",
+			"/usr/lib/chromium/chromium",
+			"/snap/bin/chromium",
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+		},
+		BrowserEdge: {
+			"/opt/microsoft/msedge/msedge",
+			"/Applications/Microsoft Edge.app/Contents/MacOS/Microsoft Edge",
+		},
+	}
+
+	driverNames = map[Browser]string{
+		BrowserChrome: "chromedriver",
+		BrowserEdge:   "msedgedriver",
+	}
+
+	driverLocations = map[Browser][]string{
+		BrowserChrome: {
+			"/usr/lib/chromium/chromedriver",
+			"/usr/lib/chromium-browser/chromedriver",
+			"/snap/bin/chromium.chromedriver",
+		},
+		BrowserEdge: {
+			"/opt/microsoft/msedge/msedgedriver",
+		},
+	}
+)
+
+// FindBrowser returns the path of the installed browser.
+func (r *DriverResolver) FindBrowser() (string, error) {
+	if r.opts.BrowserPath != "" {
+		return r.opts.BrowserPath, nil
+	}
+
+	return findBinary(browserNames[r.browser], browserLocations[r.browser])
+}
+
+// FindDriver returns the path of an installed driver. Its version may not match the browser.
+func (r *DriverResolver) FindDriver() (string, error) {
+	return findBinary([]string{driverNames[r.browser]}, driverLocations[r.browser])
+}
+
+// BrowserVersion returns the configured browser version or the version of the installed browser.
+func (r *DriverResolver) BrowserVersion(ctx context.Context) (string, error) {
+	if r.opts.BrowserVersion != "" {
+		return r.opts.BrowserVersion, nil
+	}
+
+	browser, err := r.FindBrowser()
+	if err != nil {
+		return "", err
+	}
+
+	return BinaryVersion(ctx, browser)
+}
+
+// Resolve returns the path of a driver whose major version matches the browser. It prefers an
+// installed driver, then a cached driver, and downloads the driver otherwise.
+func (r *DriverResolver) Resolve(ctx context.Context) (string, error) {
+	browserVersion, err := r.BrowserVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	major := majorVersion(browserVersion)
+
+	if driver, err := r.FindDriver(); err == nil {
+		if version, err := BinaryVersion(ctx, driver); err == nil && majorVersion(version) == major {
+			return driver, nil
+		}
+	}
+
+	if driver, ok := r.cachedDriver(major); ok {
+		return driver, nil
+	}
+
+	if r.opts.Offline {
+		return "", fmt.Errorf("no %s for %s %s installed or cached", driverNames[r.browser], r.browser, browserVersion)
+	}
+
+	return r.download(ctx, browserVersion)
+}
+
+// cachedDriver returns the newest cached driver with the major version.
+func (r *DriverResolver) cachedDriver(major string) (string, bool) {
+	entries, err := os.ReadDir(filepath.Join(r.opts.CacheDir, driverNames[r.browser]))
+	if err != nil {
+		return "", false
+	}
+
+	var versions []string
+
+	for _, e := range entries {
+		if e.IsDir() && majorVersion(e.Name()) == major {
+			versions = append(versions, e.Name())
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersions(versions[i], versions[j]) > 0
+	})
+
+	for _, v := range versions {
+		driver := r.cachePath(v)
+		if isExecutable(driver) {
+			return driver, true
+		}
+	}
+
+	return "", false
+}
+
+func (r *DriverResolver) cachePath(version string) string {
+	return filepath.Join(r.opts.CacheDir, driverNames[r.browser], version, driverBinaryName(r.browser))
+}
+
+func (r *DriverResolver) download(ctx context.Context, browserVersion string) (string, error) {
+	version := browserVersion
+
+	archive, err := r.fetchArchive(ctx, version)
+	if errors.Is(err, errNotFound) {
+		// Not every browser build has a driver of the same version.
+		latest, lerr := r.fetch(ctx, fmt.Sprintf("%s/LATEST_RELEASE_%s", r.opts.MirrorURL, majorVersion(browserVersion)))
+		if lerr != nil {
+			return "", fmt.Errorf("no %s for %s %s: %w", driverNames[r.browser], r.browser, browserVersion, lerr)
+		}
+
+		version = decodeVersionFile(latest.data)
+		if !driverVersionPattern.MatchString(version) {
+			return "", fmt.Errorf("invalid driver version %q", version)
+		}
+
+		archive, err = r.fetchArchive(ctx, version)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	driver := r.cachePath(version)
+	if err := os.MkdirAll(filepath.Dir(driver), 0755); err != nil { // nolint gosec
+		return "", err
+	}
+
+	if err := extractDriver(archive, driverBinaryName(r.browser), driver); err != nil {
+		return "", err
+	}
+
+	return driver, nil
+}
+
+func (r *DriverResolver) archiveURL(version string) string {
+	p := r.opts.Platform
+
+	if r.browser == BrowserEdge {
+		return fmt.Sprintf("%s/%s/edgedriver_%s.zip", r.opts.MirrorURL, version, p)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/chromedriver-%s.zip", r.opts.MirrorURL, version, p, p)
+}
+
+// fetchArchive downloads the driver archive and verifies its checksum.
+func (r *DriverResolver) fetchArchive(ctx context.Context, version string) ([]byte, error) {
+	archiveURL := r.archiveURL(version)
+
+	res, err := r.fetch(ctx, archiveURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.verify(ctx, archiveURL, res); err != nil {
+		return nil, err
+	}
+
+	return res.data, nil
+}
+
+var errNotFound = errors.New("not found")
+
+type fetchResult struct {
+	data   []byte
+	header http.Header
+}
+
+func (r *DriverResolver) fetch(ctx context.Context, url string) (*fetchResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusForbidden {
+		return nil, fmt.Errorf("%s: %w", url, errNotFound)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: unexpected status %s", url, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fetchResult{data: data, header: res.Header}, nil
+}
+
+// verify checks the archive against a SHA-256 checksum file next to it or, if there is none,
+// against the MD5 hash reported by the storage server.
+func (r *DriverResolver) verify(ctx context.Context, archiveURL string, res *fetchResult) error {
+	sum, err := r.fetch(ctx, archiveURL+".sha256")
+	if err == nil {
+		fields := strings.Fields(string(sum.data))
+		if len(fields) == 0 {
+			return fmt.Errorf("empty checksum file for %s", archiveURL)
+		}
+
+		actual := sha256.Sum256(res.data)
+		if !strings.EqualFold(fields[0], hex.EncodeToString(actual[:])) {
+			return fmt.Errorf("checksum mismatch for %s", archiveURL)
+		}
+
+		return nil
+	}
+
+	if !errors.Is(err, errNotFound) {
+		return err
+	}
+
+	expected := res.header.Get("Content-MD5")
+
+	for _, hash := range strings.Split(res.header.Get("X-Goog-Hash"), ",") {
+		if v := strings.TrimSpace(hash); strings.HasPrefix(v, "md5=") {
+			expected = strings.TrimPrefix(v, "md5=")
+		}
+	}
+
+	if expected == "" {
+		return fmt.Errorf("no checksum available for %s", archiveURL)
+	}
+
+	actual := md5.Sum(res.data) // nolint gosec
+	if expected != base64.StdEncoding.EncodeToString(actual[:]) {
+		return fmt.Errorf("checksum mismatch for %s", archiveURL)
+	}
+
+	return nil
+}
+
+// extractDriver writes the driver binary from the zip archive to dst.
+func extractDriver(archive []byte, binary, dst string) error {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		if path.Base(f.Name) != binary || f.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+
+		defer rc.Close()
+
+		tmp, err := os.CreateTemp(filepath.Dir(dst), binary+".*.tmp")
+		if err != nil {
+			return err
+		}
+
+		defer os.Remove(tmp.Name())
+
+		if _, err := io.Copy(tmp, rc); err != nil { // nolint gosec
+			_ = tmp.Close()
+			return err
+		}
+
+		if err := tmp.Close(); err != nil {
+			return err
+		}
+
+		if err := os.Chmod(tmp.Name(), 0755); err != nil { // nolint gosec
+			return err
+		}
+
+		// Renaming keeps concurrent resolvers from seeing a partially written driver.
+		return os.Rename(tmp.Name(), dst)
+	}
+
+	return fmt.Errorf("%s not found in archive", binary)
+}
+
+var versionPattern = regexp.MustCompile(`\d+(\.\d+){1,3}`)
+
+// driverVersionPattern matches a version file. The version is used as a directory name of the cache.
+var driverVersionPattern = regexp.MustCompile(`^\d+(\.\d+){1,3}$`)
+
+// BinaryVersion runs the browser or driver binary with --version and returns its version.
+func BinaryVersion(ctx context.Context, path string) (string, error) {
+	out, err := exec.CommandContext(ctx, path, "--version").Output() // nolint gosec
+	if err != nil {
+		return "", fmt.Errorf("%s --version: %w", path, err)
+	}
+
+	version := versionPattern.FindString(string(out))
+	if version == "" {
+		return "", fmt.Errorf("no version in output of %s: %s", path, strings.TrimSpace(string(out)))
+	}
+
+	return version, nil
+}
+
+// decodeVersionFile decodes a LATEST_RELEASE file. Edge publishes them in UTF-16 with a byte order mark.
+func decodeVersionFile(data []byte) string {
+	if bytes.HasPrefix(data, []byte{0xff, 0xfe}) {
+		data = bytes.ReplaceAll(data[2:], []byte{0}, nil)
+	}
+
+	return strings.TrimSpace(string(data))
+}
+
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
+
+// compareVersions compares dotted versions numerically.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func findBinary(names, locations []string) (string, error) {
+	for _, name := range names {
+		if p, err := exec.LookPath(name); err == nil {
+			return p, nil
+		}
+	}
+
+	for _, p := range locations {
+		if isExecutable(p) {
+			return p, nil
+		}
+	}
+
+	return "", fmt.Errorf("none of %s found", strings.Join(names, ", "))
+}
+
+func isExecutable(p string) bool {
+	info, err := os.Stat(p)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	return runtime.GOOS == "windows" || info.Mode()&0111 != 0
+}
+
+func driverBinaryName(browser Browser) string {
+	if runtime.GOOS == "windows" {
+		return driverNames[browser] + ".exe"
+	}
+
+	return driverNames[browser]
+}
+
+func driverPlatform(browser Browser) string {
+	switch runtime.GOOS {
+	case "windows":
+		if runtime.GOARCH == "386" {
+			return "win32"
+		}
+
+		return "win64"
+	case "darwin":
+		if browser == BrowserEdge {
+			if runtime.GOARCH == "arm64" {
+				return "mac64_m1"
+			}
+
+			return "mac64"
+		}
+
+		if runtime.GOARCH == "arm64" {
+			return "mac-arm64"
+		}
+
+		return "mac-x64"
+	}
+
+	if browser == BrowserEdge && runtime.GOARCH == "arm64" {
+		return "arm64"
+	}
+
+	return "linux64"
+}
@@ -0,0 +1,141 @@
+package webdriver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriverResolver(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("driver stub is a shell script")
+	}
+
+	// Keep installed drivers out of the lookup.
+	t.Setenv("PATH", t.TempDir())
+
+	driver := []byte("#!/bin/sh\necho ChromeDriver 120.0.6099.109\n")
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	w, err := zw.Create("chromedriver-linux64/chromedriver")
+	assert.NoError(t, err)
+	_, _ = w.Write(driver)
+	assert.NoError(t, zw.Close())
+
+	archive := buf.Bytes()
+	sum := sha256.Sum256(archive)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/LATEST_RELEASE_120", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("120.0.6099.109\n"))
+	})
+	mux.HandleFunc("/120.0.6099.109/linux64/chromedriver-linux64.zip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+	mux.HandleFunc("/120.0.6099.109/linux64/chromedriver-linux64.zip.sha256", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(hex.EncodeToString(sum[:]) + "  chromedriver-linux64.zip\n"))
+	})
+
+	mirror := httptest.NewServer(mux)
+	defer mirror.Close()
+
+	cacheDir := t.TempDir()
+
+	resolver, err := NewDriverResolver(BrowserChrome, func(o *DriverResolverOptions) {
+		o.BrowserVersion = "120.0.6099.71"
+		o.CacheDir = cacheDir
+		o.MirrorURL = mirror.URL
+		o.Platform = "linux64"
+	})
+	assert.NoError(t, err)
+
+	path, err := resolver.Resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(cacheDir, "chromedriver", "120.0.6099.109", "chromedriver"), path)
+
+	version, err := BinaryVersion(context.Background(), path)
+	assert.NoError(t, err)
+	assert.Equal(t, "120.0.6099.109", version)
+
+	// The cached driver is used without the mirror.
+	mirror.Close()
+
+	offline, err := NewDriverResolver(BrowserChrome, func(o *DriverResolverOptions) {
+		o.BrowserVersion = "120.0.6099.200"
+		o.CacheDir = cacheDir
+		o.Offline = true
+	})
+	assert.NoError(t, err)
+
+	cached, err := offline.Resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, path, cached)
+
+	offline.opts.BrowserVersion = "121.0.6167.85"
+
+	_, err = offline.Resolve(context.Background())
+	assert.Error(t, err)
+}
+
+func TestDriverResolverChecksumMismatch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/120.0.6099.109/linux64/chromedriver-linux64.zip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tampered"))
+	})
+	mux.HandleFunc("/120.0.6099.109/linux64/chromedriver-linux64.zip.sha256", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("0000"))
+	})
+
+	mirror := httptest.NewServer(mux)
+	defer mirror.Close()
+
+	resolver, err := NewDriverResolver(BrowserChrome, func(o *DriverResolverOptions) {
+		o.BrowserVersion = "120.0.6099.109"
+		o.CacheDir = t.TempDir()
+		o.MirrorURL = mirror.URL
+		o.Platform = "linux64"
+	})
+	assert.NoError(t, err)
+
+	t.Setenv("PATH", t.TempDir())
+
+	_, err = resolver.Resolve(context.Background())
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = os.Stat(filepath.Join(resolver.opts.CacheDir, "chromedriver", "120.0.6099.109", "chromedriver"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDriverResolverInvalidLatestRelease(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/LATEST_RELEASE_120", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html>see 120.0.6099.109 for details</html>"))
+	})
+
+	mirror := httptest.NewServer(mux)
+	defer mirror.Close()
+
+	resolver, err := NewDriverResolver(BrowserChrome, func(o *DriverResolverOptions) {
+		o.BrowserVersion = "120.0.6099.71"
+		o.CacheDir = t.TempDir()
+		o.MirrorURL = mirror.URL
+		o.Platform = "linux64"
+	})
+	assert.NoError(t, err)
+
+	t.Setenv("PATH", t.TempDir())
+
+	_, err = resolver.Resolve(context.Background())
+	assert.ErrorContains(t, err, "invalid driver version")
+}
@@ -1,105 +1,435 @@
 package webdriver
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
-	"runtime"
-	"syscall"
+	"strings"
+	"sync"
 	"time"
 )
 
 type CheckStatusFunc func() bool
 
+// Logger receives the output of a driver line by line. *slog.Logger implements it.
+type Logger interface {
+	Info(msg string, args ...any)
+}
+
+type ServiceOptions struct {
+	// Stdout receives the standard output of the driver. Defaults to os.Stdout, io.Discard drops it.
+	Stdout io.Writer
+
+	// Stderr receives the standard error of the driver. Defaults to os.Stderr, io.Discard drops it.
+	Stderr io.Writer
+
+	// Logger receives every output line of the driver in addition to Stdout and Stderr
+	Logger Logger
+
+	// OutputLines is the number of last output lines attached to errors. Defaults to 50.
+	OutputLines int
+
+	// StopTimeout is the grace period for the driver to exit after it was asked to stop, before it
+	// is killed. Defaults to 5 seconds.
+	StopTimeout time.Duration
+
+	// StartedLine is a part of the output line the driver prints once it listens on its port. If it
+	// is set, WaitForBoot does not trust a successful status check until the line was printed, as the
+	// status may have been answered by another process holding the port. Drivers that do not print
+	// the line are trusted once they are still running a second after the status check.
+	StartedLine string
+}
+
 type Service struct {
-	path string
-	args []string
-	cmd  *exec.Cmd
+	path     string
+	args     []string
+	opts     ServiceOptions
+	mu       sync.Mutex
+	proc     *process
+	outputMu sync.Mutex
+	output   *lineBuffer // output of the last process
 }
 
-func NewService(path string, args []string) *Service {
+// process is a single run of the driver.
+type process struct {
+	cmd    *exec.Cmd
+	group  *processGroup
+	done   chan struct{} // closed once the process has exited
+	err    error         // exit error, set before done is closed
+	output sync.WaitGroup
+	lines  *lineBuffer
+
+	started     chan struct{} // closed once the started line was printed
+	startedOnce sync.Once
+}
+
+// startedGrace is how long a driver that does not print the started line must keep running after a
+// successful status check.
+const startedGrace = time.Second
+
+func NewService(path string, args []string, optFns ...func(o *ServiceOptions)) *Service {
+	opts := ServiceOptions{
+		Stdout:      os.Stdout,
+		Stderr:      os.Stderr,
+		OutputLines: 50,              // nolint gomnd
+		StopTimeout: 5 * time.Second, // nolint gomnd
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
 	return &Service{
-		path: path,
-		args: args,
+		path:   path,
+		args:   args,
+		opts:   opts,
+		output: newLineBuffer(0),
 	}
 }
 
+// Start starts the driver in its own process group. Processes of the group that outlive the driver
+// are killed once it exits. A stopped service can be started again.
 func (s *Service) Start() error {
-	if s.cmd != nil {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.proc != nil {
 		return errors.New("webdriver already running")
 	}
 
-	s.cmd = exec.Command(s.path, s.args...) // nolint gosec
+	cmd := exec.Command(s.path, s.args...) // nolint gosec
+	setProcessGroup(cmd)
+
+	p := s.newProcess(cmd)
 
-	stdout, err := s.cmd.StdoutPipe()
+	// The output is copied from pipes owned by the service, so waiting for the process does not
+	// wait for browsers that inherited the output.
+	stdout, err := s.startOutput(p, "stdout", s.opts.Stdout)
 	if err != nil {
 		return err
 	}
 
-	stderr, err := s.cmd.StderrPipe()
+	stderr, err := s.startOutput(p, "stderr", s.opts.Stderr)
 	if err != nil {
+		_ = stdout.Close()
 		return err
 	}
 
-	if err := s.cmd.Start(); err != nil {
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Start()
+
+	_ = stdout.Close()
+	_ = stderr.Close()
+
+	if err != nil {
+		return err
+	}
+
+	if p.group, err = newProcessGroup(cmd); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+
 		return err
 	}
 
 	go func() {
-		_, _ = io.Copy(os.Stdout, stdout)
-	}()
-	go func() {
-		_, _ = io.Copy(os.Stderr, stderr)
+		p.err = p.group.wait(cmd)
+		close(p.done)
 	}()
 
+	s.proc = p
+
+	// Errors of this run only include its own output.
+	s.outputMu.Lock()
+	s.output = p.lines
+	s.outputMu.Unlock()
+
 	return nil
 }
 
+func (s *Service) newProcess(cmd *exec.Cmd) *process {
+	return &process{
+		cmd:     cmd,
+		done:    make(chan struct{}),
+		started: make(chan struct{}),
+		lines:   newLineBuffer(s.opts.OutputLines),
+	}
+}
+
+// Stop asks the driver to exit and kills it if it does not exit within the stop timeout. Processes
+// started by the driver, such as browsers, are killed as well.
 func (s *Service) Stop() error {
-	if s.cmd == nil {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.proc
+	if p == nil {
 		return errors.New("webDriver not running")
 	}
 
-	if runtime.GOOS == "windows" {
-		if err := s.cmd.Process.Kill(); err != nil {
-			return err
+	s.proc = nil
+
+	select {
+	case <-p.done:
+	default:
+		if err := p.group.terminate(); err != nil {
+			_ = p.group.kill()
 		}
-	} else {
-		if err := s.cmd.Process.Signal(syscall.SIGTERM); err != nil {
-			return err
+
+		select {
+		case <-p.done:
+		case <-time.After(s.opts.StopTimeout):
+			if err := p.group.kill(); err != nil {
+				return err
+			}
+
+			<-p.done
 		}
 	}
 
 	return nil
 }
 
+// WaitForBoot polls fn with an increasing interval until it reports that the driver is up. If the
+// StartedLine option is set, it also waits for the driver to print it. It fails as soon as the driver
+// process exits or when the timeout expires.
 func (s *Service) WaitForBoot(timeout time.Duration, fn CheckStatusFunc) error {
+	s.mu.Lock()
+	p := s.proc
+	s.mu.Unlock()
+
+	if p == nil {
+		return errors.New("webDriver not running")
+	}
+
 	timeoutChan := time.After(timeout)
 
-	failedChan := make(chan struct{}, 1)
+	failedChan := make(chan struct{})
 	startedChan := make(chan struct{})
 
 	go func() {
-		up := fn()
-		for !up {
+		delay := 10 * time.Millisecond // nolint gomnd
+
+		for !fn() {
 			select {
 			case <-failedChan:
 				return
-			default:
-				time.Sleep(500 * time.Millisecond) // nolint gomnd
+			case <-time.After(delay):
+			}
 
-				up = fn()
+			if delay *= 2; delay > 500*time.Millisecond { // nolint gomnd
+				delay = 500 * time.Millisecond // nolint gomnd
 			}
 		}
-		startedChan <- struct{}{}
+
+		close(startedChan)
 	}()
 
 	select {
 	case <-timeoutChan:
-		failedChan <- struct{}{}
-		return errors.New("failed to start before timeout")
+		close(failedChan)
+		return s.error(errors.New("failed to start before timeout"))
+	case <-p.done:
+		close(failedChan)
+		return s.exitError(p)
 	case <-startedChan:
+	}
+
+	if s.opts.StartedLine == "" {
+		select {
+		case <-p.done:
+			return s.exitError(p)
+		default:
+			return nil
+		}
+	}
+
+	// The status check may have reached another process listening on the port.
+	select {
+	case <-p.started:
 		return nil
+	case <-p.done:
+		return s.exitError(p)
+	case <-time.After(startedGrace):
+		return nil
+	case <-timeoutChan:
+		return s.error(errors.New("failed to start before timeout"))
+	}
+}
+
+// exitError returns the error for a driver that exited during boot.
+func (s *Service) exitError(p *process) error {
+	p.waitOutput(100 * time.Millisecond) // nolint gomnd
+
+	if p.err == nil {
+		return s.error(errors.New("driver exited during boot with exit code 0"))
 	}
+
+	return s.error(fmt.Errorf("driver exited during boot: %w", p.err))
+}
+
+// waitOutput waits until the output of the process has been read. Processes started by the driver
+// may keep the output open, so it waits at most timeout.
+func (p *process) waitOutput(timeout time.Duration) {
+	done := make(chan struct{})
+
+	go func() {
+		p.output.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
+// Output returns the last output lines of the current or last run of the driver.
+func (s *Service) Output() []string {
+	s.outputMu.Lock()
+	output := s.output
+	s.outputMu.Unlock()
+
+	return output.Lines()
+}
+
+// ServiceError is returned if the driver fails. It includes the last output lines of the driver.
+type ServiceError struct {
+	Err    error
+	Output []string
+}
+
+func (e *ServiceError) Error() string {
+	if len(e.Output) == 0 {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s\ndriver output:\n%s", e.Err, strings.Join(e.Output, "\n"))
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+func (s *Service) error(err error) error {
+	return &ServiceError{Err: err, Output: s.Output()}
+}
+
+// startOutput returns the write end of a pipe whose output is passed to w line by line.
+func (s *Service) startOutput(p *process, stream string, w io.Writer) (*os.File, error) {
+	r, pw, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	p.output.Add(1)
+
+	go func() {
+		defer p.output.Done()
+
+		_, _ = io.Copy(s.newOutputWriter(p, stream, w), r)
+		_ = r.Close()
+	}()
+
+	return pw, nil
+}
+
+func (s *Service) newOutputWriter(p *process, stream string, w io.Writer) io.Writer {
+	return &lineWriter{
+		emit: func(line string) {
+			p.lines.Add(line)
+
+			if s.opts.StartedLine != "" && strings.Contains(line, s.opts.StartedLine) {
+				p.startedOnce.Do(func() { close(p.started) })
+			}
+
+			if s.opts.Logger != nil {
+				s.opts.Logger.Info(line, "driver", s.path, "stream", stream)
+			}
+		},
+		w: w,
+	}
+}
+
+// lineWriter passes output through to w and emits it line by line. It stops writing to w after
+// the first error.
+type lineWriter struct {
+	mu      sync.Mutex
+	partial []byte
+	emit    func(line string)
+	w       io.Writer
+}
+
+func (lw *lineWriter) Write(p []byte) (int, error) {
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+
+	lw.partial = append(lw.partial, p...)
+
+	for {
+		i := bytes.IndexByte(lw.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		lw.emit(strings.TrimRight(string(lw.partial[:i]), "\r"))
+		lw.partial = lw.partial[i+1:]
+	}
+
+	// A failing writer must not stop reading the pipe, or the driver blocks once it is full.
+	if lw.w != nil {
+		if _, err := lw.w.Write(p); err != nil {
+			lw.w = nil
+		}
+	}
+
+	return len(p), nil
+}
+
+// lineBuffer keeps the last lines written to it.
+type lineBuffer struct {
+	mu    sync.Mutex
+	lines []string
+	next  int
+	full  bool
+}
+
+func newLineBuffer(size int) *lineBuffer {
+	if size < 0 {
+		size = 0
+	}
+
+	return &lineBuffer{lines: make([]string, size)}
+}
+
+func (b *lineBuffer) Add(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.lines) == 0 {
+		return
+	}
+
+	b.lines[b.next] = line
+	b.next = (b.next + 1) % len(b.lines)
+
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+func (b *lineBuffer) Lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.full {
+		return append([]string(nil), b.lines[:b.next]...)
+	}
+
+	return append(append([]string(nil), b.lines[b.next:]...), b.lines[:b.next]...)
 }
@@ -0,0 +1,24 @@
+package webdriver
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// waitExited blocks until the process has exited, without reaping it.
+func waitExited(pid int) bool {
+	const (
+		pPID    = 1
+		wExited = 0x4
+		wNoWait = 0x1000000
+	)
+
+	var info [128]byte // siginfo_t
+
+	for {
+		_, _, errno := syscall.Syscall6(syscall.SYS_WAITID, pPID, uintptr(pid), uintptr(unsafe.Pointer(&info)), wExited|wNoWait, 0, 0)
+		if errno != syscall.EINTR {
+			return errno == 0
+		}
+	}
+}
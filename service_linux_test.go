@@ -0,0 +1,38 @@
+package webdriver
+
+import (
+	"io"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceKillsGroupBeforeReaping(t *testing.T) {
+	// The driver exits on its own and leaves a child process behind.
+	s := NewService("sh", []string{"-c", `sleep 30 & echo $!`}, func(o *ServiceOptions) {
+		o.Stdout = io.Discard
+	})
+
+	assert.NoError(t, s.Start())
+
+	s.mu.Lock()
+	p := s.proc
+	s.mu.Unlock()
+
+	<-p.done
+	p.waitOutput(time.Second)
+
+	child, err := strconv.Atoi(s.Output()[0])
+	assert.NoError(t, err)
+
+	// The child was killed before the driver was reaped.
+	assert.Eventually(t, func() bool {
+		return syscall.Kill(child, 0) == syscall.ESRCH
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Stop does not signal the reaped process group.
+	assert.NoError(t, s.Stop())
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !windows
+
+package webdriver
+
+// waitExited reports false, as the exit of a process cannot be observed without reaping it.
+func waitExited(pid int) bool {
+	return false
+}
@@ -0,0 +1,189 @@
+package webdriver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Info(msg string, args ...any) {
+	l.lines = append(l.lines, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func TestServiceOutput(t *testing.T) {
+	logger := &testLogger{}
+	stderr := &bytes.Buffer{}
+
+	opts := Options{
+		LogLevel: DriverLogLevelDebug,
+		Verbose:  true,
+		Stderr:   stderr,
+		Logger:   logger,
+	}
+
+	s := opts.newService("chromedriver", 4444, chromeDriverStartedLine)
+	assert.Equal(t, []string{"--port=4444", "--log-level=DEBUG", "--verbose"}, s.args)
+
+	p := s.newProcess(nil)
+	s.output = p.lines
+
+	w := s.newOutputWriter(p, "stderr", s.opts.Stderr)
+
+	_, _ = w.Write([]byte("first\nsec"))
+	_, _ = w.Write([]byte("ond\r\n"))
+
+	assert.Equal(t, "first\nsecond\r\n", stderr.String())
+	assert.Equal(t, []string{"first", "second"}, s.Output())
+	assert.Len(t, logger.lines, 2)
+
+	err := s.error(fmt.Errorf("boot failed"))
+	assert.EqualError(t, err, "boot failed\ndriver output:\nfirst\nsecond")
+
+	// A failing writer does not stop the output from being read
+	w = s.newOutputWriter(p, "stdout", failingWriter{})
+
+	n, err := w.Write([]byte("third\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, n)
+
+	_, err = w.Write([]byte("fourth\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second", "third", "fourth"}, s.Output())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+func TestLineBuffer(t *testing.T) {
+	b := newLineBuffer(2)
+	assert.Empty(t, b.Lines())
+
+	b.Add("a")
+	b.Add("b")
+	b.Add("c")
+	assert.Equal(t, []string{"b", "c"}, b.Lines())
+}
+
+func TestServiceStop(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	// The driver ignores SIGTERM and has a child process that has to be cleaned up as well.
+	s := NewService("sh", []string{"-c", `trap "" TERM; sleep 30 & echo started; wait`}, func(o *ServiceOptions) {
+		o.Stdout = io.Discard
+		o.StopTimeout = 200 * time.Millisecond
+	})
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, s.Start())
+		assert.Error(t, s.Start())
+
+		// Each run has its own output
+		assert.Eventually(t, func() bool {
+			return len(s.Output()) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"started"}, s.Output())
+
+		start := time.Now()
+		assert.NoError(t, s.Stop())
+		assert.Less(t, time.Since(start), 5*time.Second)
+	}
+
+	assert.Error(t, s.Stop())
+}
+
+func TestServiceWaitForBootExit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	s := NewService("sh", []string{"-c", `echo "bind() failed: Address already in use" >&2; exit 3`}, func(o *ServiceOptions) {
+		o.Stderr = io.Discard
+	})
+
+	assert.NoError(t, s.Start())
+
+	start := time.Now()
+	err := s.WaitForBoot(10*time.Second, func() bool { return false })
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	var exitErr *exec.ExitError
+	if assert.True(t, errors.As(err, &exitErr)) {
+		assert.Equal(t, 3, exitErr.ExitCode())
+	}
+
+	assert.ErrorContains(t, err, "Address already in use")
+	assert.NoError(t, s.Stop())
+}
+
+func TestDriverStartPortHeld(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	// Another driver holds the port and answers the status checks of the new driver.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value":{"ready":true}}`)
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	port, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+
+	newDriver := func(script string) *webDriver {
+		path := filepath.Join(t.TempDir(), "driver")
+		assert.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0700)) // nolint gosec
+
+		d := &webDriver{}
+		assert.NoError(t, d.init(path, chromeDriverStartedLine, Options{
+			Port:        port,
+			BootTimeout: 10 * time.Second,
+			Stdout:      io.Discard,
+			Stderr:      io.Discard,
+		}))
+
+		return d
+	}
+
+	failing := newDriver("sleep 0.3; echo 'bind() failed: Address already in use' >&2; exit 1\n")
+
+	err = failing.Start()
+	assert.True(t, isAddressInUse(err), err)
+	assert.True(t, failing.portConflict(err))
+
+	// The conflict is not in the output if the driver log is written to a file
+	logged := newDriver("sleep 0.3; exit 1\n")
+
+	err = logged.Start()
+	assert.False(t, isAddressInUse(err), err)
+	assert.True(t, logged.portConflict(err))
+
+	started := newDriver("echo 'ChromeDriver was started successfully on port $1.'; exec sleep 30\n")
+
+	assert.NoError(t, started.Start())
+	assert.NoError(t, started.Stop())
+}
@@ -0,0 +1,76 @@
+//go:build !windows
+
+package webdriver
+
+import (
+	"os/exec"
+	"sync"
+	"syscall"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// processGroup is the process group of the driver. Its ID is the process ID of the driver, so the
+// group is only signaled until the driver has been reaped and its ID may be reused.
+type processGroup struct {
+	mu     sync.Mutex
+	pid    int
+	reaped bool
+}
+
+func newProcessGroup(cmd *exec.Cmd) (*processGroup, error) {
+	return &processGroup{pid: cmd.Process.Pid}, nil
+}
+
+// terminate sends SIGTERM to the process group.
+func (g *processGroup) terminate() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.signal(syscall.SIGTERM)
+}
+
+// kill sends SIGKILL to the process group.
+func (g *processGroup) kill() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.signal(syscall.SIGKILL)
+}
+
+func (g *processGroup) signal(sig syscall.Signal) error {
+	if g.reaped {
+		return nil
+	}
+
+	err := syscall.Kill(-g.pid, sig)
+	if err == syscall.ESRCH {
+		return nil
+	}
+
+	return err
+}
+
+// wait waits for the driver to exit. Where the exit can be observed without reaping the driver,
+// processes of the group that outlived it are killed before it is reaped.
+func (g *processGroup) wait(cmd *exec.Cmd) error {
+	if waitExited(g.pid) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		_ = g.signal(syscall.SIGKILL)
+		g.reaped = true
+
+		return cmd.Wait()
+	}
+
+	err := cmd.Wait()
+
+	g.mu.Lock()
+	g.reaped = true
+	g.mu.Unlock()
+
+	return err
+}
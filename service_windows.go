@@ -0,0 +1,93 @@
+//go:build windows
+
+package webdriver
+
+import (
+	"os/exec"
+	"sync"
+	"syscall"
+)
+
+var (
+	kernel32                     = syscall.NewLazyDLL("kernel32.dll")
+	procCreateJobObjectW         = kernel32.NewProc("CreateJobObjectW")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+	procTerminateJobObject       = kernel32.NewProc("TerminateJobObject")
+)
+
+const processSetQuota = 0x0100
+
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// processGroup is a job object the driver is assigned to. Processes started by the driver join the
+// job, so the whole tree is killed without relying on process IDs that may be reused.
+type processGroup struct {
+	mu     sync.Mutex
+	job    syscall.Handle
+	closed bool
+}
+
+func newProcessGroup(cmd *exec.Cmd) (*processGroup, error) {
+	job, _, err := procCreateJobObjectW.Call(0, 0)
+	if job == 0 {
+		return nil, err
+	}
+
+	// The process is not waited for yet, so its ID has not been reused.
+	process, err := syscall.OpenProcess(processSetQuota|syscall.PROCESS_TERMINATE, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		_ = syscall.CloseHandle(syscall.Handle(job))
+		return nil, err
+	}
+
+	defer syscall.CloseHandle(process) // nolint errcheck
+
+	if ok, _, err := procAssignProcessToJobObject.Call(job, uintptr(process)); ok == 0 {
+		_ = syscall.CloseHandle(syscall.Handle(job))
+		return nil, err
+	}
+
+	return &processGroup{job: syscall.Handle(job)}, nil
+}
+
+// terminate kills the process tree. Windows has no graceful equivalent of SIGTERM for console
+// processes without a window.
+func (g *processGroup) terminate() error {
+	return g.kill()
+}
+
+// kill kills the process tree.
+func (g *processGroup) kill() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.terminateJob()
+}
+
+func (g *processGroup) terminateJob() error {
+	if g.closed {
+		return nil
+	}
+
+	if ok, _, err := procTerminateJobObject.Call(uintptr(g.job), 1); ok == 0 {
+		return err
+	}
+
+	return nil
+}
+
+// wait waits for the driver to exit and kills the processes that outlived it.
+func (g *processGroup) wait(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	_ = g.terminateJob()
+	_ = syscall.CloseHandle(g.job)
+	g.closed = true
+
+	return err
+}
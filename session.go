@@ -169,9 +169,23 @@ func (s *Session) SwitchToWindow(handle string) error {
 	return err
 }
 
+// NewWindow opens a new top-level browsing context, a tab or a window, without switching to it.
+// Browsers that cannot open the requested type open the other one.
+func (s *Session) NewWindow(windowType WindowType) (*Window, error) {
+	data, err := s.client.Post(fmt.Sprintf("/session/%s/window/new", s.ID), &Params{"type": windowType})
+	if err != nil {
+		return nil, err
+	}
+
+	window := &Window{session: s}
+	err = json.Unmarshal(data, window)
+
+	return window, err
+}
+
 // GetWindowHandles gets all window handles.
 func (s *Session) GetWindowHandles() ([]string, error) {
-	data, err := s.client.Get(fmt.Sprintf("/session/%s/window", s.ID))
+	data, err := s.client.Get(fmt.Sprintf("/session/%s/window/handles", s.ID))
 	if err != nil {
 		return nil, err
 	}
@@ -184,10 +198,97 @@ func (s *Session) GetWindowHandles() ([]string, error) {
 
 // SwitchToFrame changes focus to another frame on the page.
 func (s *Session) SwitchToFrame(target Element) error {
-	_, err := s.client.Post(fmt.Sprintf("/session/%s/frame", s.ID), &Params{"id": target.ID})
+	return s.switchToFrame(target)
+}
+
+// SwitchToFrameIndex changes focus to the frame with the index in the current browsing context,
+// like window.frames[index].
+func (s *Session) SwitchToFrameIndex(index int) error {
+	return s.switchToFrame(index)
+}
+
+// SwitchToFrameBy changes focus to the frame element located by by, for example ByID or ByName.
+func (s *Session) SwitchToFrameBy(by By) error {
+	frame, err := s.FindElementBy(by)
+	if err != nil {
+		return err
+	}
+
+	return s.switchToFrame(frame)
+}
+
+// SwitchToTopFrame changes focus to the top-level browsing context.
+func (s *Session) SwitchToTopFrame() error {
+	return s.switchToFrame(nil)
+}
+
+func (s *Session) switchToFrame(id interface{}) error {
+	_, err := s.client.Post(fmt.Sprintf("/session/%s/frame", s.ID), &Params{"id": id})
 	return err
 }
 
+// WithinFrame switches to the frame element located by by, runs fn and switches back to the parent
+// frame, even if fn fails. fn must leave the focus in the frame, which nested calls of WithinFrame do.
+func (s *Session) WithinFrame(by By, fn func() error) (err error) {
+	if err := s.SwitchToFrameBy(by); err != nil {
+		return err
+	}
+
+	defer func() {
+		if perr := s.SwitchToParentFrame(); perr != nil && err == nil {
+			err = perr
+		}
+	}()
+
+	return fn()
+}
+
+// Frame is a frame element of the current browsing context.
+type Frame struct {
+	Element Element `json:"element"`
+
+	// Index of the frame in window.frames for SwitchToFrameIndex, or -1 if the frame has no window
+	// (for example, because it is not attached to the document)
+	Index int `json:"index"`
+
+	// ID, Name and Src attributes of the frame element
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Src  string `json:"src"`
+}
+
+// GetFrames returns the frame and iframe elements of the current browsing context in document order.
+func (s *Session) GetFrames() ([]Frame, error) {
+	// Frames inside shadow roots are part of window.frames but not found by querySelectorAll, so the
+	// index is looked up instead of being derived from the document order.
+	data, err := s.ExecuteScript(`const index = (e) => {
+	for (let i = 0; i < window.frames.length; i++) {
+		if (window.frames[i] === e.contentWindow) {
+			return i;
+		}
+	}
+	return -1;
+};
+return Array.from(document.querySelectorAll("iframe, frame"), (e) => ({
+	element: e, index: index(e), id: e.id, name: e.name, src: e.getAttribute("src") || ""
+}));`, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	var frames []Frame
+	if err := json.Unmarshal(data, &frames); err != nil {
+		return nil, err
+	}
+
+	for i := range frames {
+		frames[i].Element.SessionID = s.ID
+		frames[i].Element.client = s.client
+	}
+
+	return frames, nil
+}
+
 // SwitchToParentFrame changes focus to parent frame on the page.
 func (s *Session) SwitchToParentFrame() error {
 	_, err := s.client.Post(fmt.Sprintf("/session/%s/frame/parent", s.ID), nil)
@@ -308,6 +409,16 @@ func (s *Session) FindElements(strategy LocatorStrategy, selector string) ([]Ele
 	return elements, nil
 }
 
+// FindElementBy returns the first element located by by, starting from the document root.
+func (s *Session) FindElementBy(by By) (*Element, error) {
+	return findElementBy(s, by)
+}
+
+// FindElementsBy returns the elements located by by, starting from the document root.
+func (s *Session) FindElementsBy(by By) ([]Element, error) {
+	return by.FindElements(s)
+}
+
 // GetActiveElement gets the element on the page that currently has focus.
 func (s *Session) GetActiveElement() (*Element, error) {
 	data, err := s.client.Get(fmt.Sprintf("/session/%s/element/active", s.ID))
@@ -523,6 +634,32 @@ func (s *Session) TakeScreenshot() ([]byte, error) {
 	return base64.StdEncoding.DecodeString(screenshot)
 }
 
+/****************************************************************************************************************
+ *                                               PERMISSIONS                                                    *
+ *                       https://w3c.github.io/permissions/#automation-webdriver-extension                      *
+ ****************************************************************************************************************/
+
+type PermissionState string
+
+const (
+	PermissionStateGranted PermissionState = "granted"
+	PermissionStateDenied  PermissionState = "denied"
+	PermissionStatePrompt  PermissionState = "prompt"
+)
+
+// SetPermission sets the state of the permission with the given name, for example "camera" or
+// "clipboard-read", for the current browsing context's origin.
+func (s *Session) SetPermission(name string, state PermissionState) error {
+	_, err := s.client.Post(fmt.Sprintf("/session/%s/permissions", s.ID), &Params{
+		"descriptor": Params{
+			"name": name,
+		},
+		"state": state,
+	})
+
+	return err
+}
+
 /****************************************************************************************************************
  *                                              PRINT                                                           *
  *                              https://www.w3.org/TR/webdriver/#print-page                                     *
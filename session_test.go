@@ -0,0 +1,82 @@
+package webdriver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionFrames(t *testing.T) {
+	remote := newTestRemote(t, func(cmd *testCommand) interface{} {
+		switch cmd.Path {
+		case "/elements":
+			return []map[string]string{elementValue("f1")}
+		case "/execute/sync":
+			return []map[string]interface{}{
+				{"element": elementValue("f1"), "index": 2, "id": "editor", "name": "", "src": "/editor.html"},
+			}
+		}
+
+		return nil
+	})
+
+	session := remote.session("s1")
+
+	assert.NoError(t, session.SwitchToFrameIndex(1))
+	assert.NoError(t, session.SwitchToTopFrame())
+	assert.NoError(t, session.SwitchToFrame(Element{ID: "f1"}))
+
+	err := session.WithinFrame(ByID("editor"), func() error {
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+
+	var calls []string
+
+	for _, cmd := range remote.commands() {
+		if strings.HasPrefix(cmd.Path, "/frame") {
+			calls = append(calls, fmt.Sprintf("%s %s", cmd.Path, cmd.Body))
+		}
+	}
+
+	assert.Equal(t, []string{
+		`/frame {"id":1}`,
+		`/frame {"id":null}`,
+		`/frame {"id":{"element-6066-11e4-a52e-4f735466cecf":"f1"}}`,
+		`/frame {"id":{"element-6066-11e4-a52e-4f735466cecf":"f1"}}`,
+		`/frame/parent {}`,
+	}, calls)
+
+	frames, err := session.GetFrames()
+	assert.NoError(t, err)
+	assert.Len(t, frames, 1)
+	assert.Equal(t, "editor", frames[0].ID)
+	assert.Equal(t, 2, frames[0].Index)
+	assert.Equal(t, "s1", frames[0].Element.SessionID)
+
+	// The index is the position in window.frames, which SwitchToFrameIndex uses
+	cmds := remote.commands()
+	assert.Contains(t, cmds[len(cmds)-1].param("script"), "window.frames[i] === e.contentWindow")
+
+	data, _ := json.Marshal(frames[0].Element)
+	assert.JSONEq(t, `{"element-6066-11e4-a52e-4f735466cecf":"f1"}`, string(data))
+}
+
+func TestSessionSetPermission(t *testing.T) {
+	remote := newTestRemote(t, nil)
+
+	session := remote.session("s1")
+
+	assert.NoError(t, session.SetPermission("clipboard-read", PermissionStatePrompt))
+
+	cmds := remote.commands()
+	assert.Len(t, cmds, 1)
+	assert.Equal(t, "POST", cmds[0].Method)
+	assert.Equal(t, "s1", cmds[0].SessionID)
+	assert.Equal(t, "/permissions", cmds[0].Path)
+	assert.JSONEq(t, `{"descriptor":{"name":"clipboard-read"},"state":"prompt"}`, cmds[0].Body)
+}
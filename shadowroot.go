@@ -2,9 +2,14 @@ package webdriver
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
+// ErrShadowRootXPath is returned for XPath locators, including ByText, in a shadow root. Drivers
+// do not support XPath there.
+var ErrShadowRootXPath = errors.New("xpath locators are not supported in shadow roots")
+
 type ShadowRoot struct {
 	ID        string      `json:"shadow-6066-11e4-a52e-4f735466cecf"`
 	SessionID string      `json:"-"`
@@ -12,6 +17,10 @@ type ShadowRoot struct {
 }
 
 func (s *ShadowRoot) FindElement(strategy LocatorStrategy, selector string) (*Element, error) {
+	if strategy == LocatorStrategyXPath {
+		return nil, fmt.Errorf("%w: %s", ErrShadowRootXPath, selector)
+	}
+
 	data, err := s.client.Post(fmt.Sprintf("/session/%s/shadow/%s/element", s.SessionID, s.ID), &Params{
 		"using": strategy,
 		"value": selector,
@@ -25,13 +34,17 @@ func (s *ShadowRoot) FindElement(strategy LocatorStrategy, selector string) (*El
 		return nil, err
 	}
 
-	element.SessionID = s.ID
+	element.SessionID = s.SessionID
 	element.client = s.client
 
 	return &element, nil
 }
 
 func (s *ShadowRoot) FindElements(strategy LocatorStrategy, selector string) ([]Element, error) {
+	if strategy == LocatorStrategyXPath {
+		return nil, fmt.Errorf("%w: %s", ErrShadowRootXPath, selector)
+	}
+
 	data, err := s.client.Post(fmt.Sprintf("/session/%s/shadow/%s/elements", s.SessionID, s.ID), &Params{
 		"using": strategy,
 		"value": selector,
@@ -46,9 +59,19 @@ func (s *ShadowRoot) FindElements(strategy LocatorStrategy, selector string) ([]
 	}
 
 	for index := range elements {
-		elements[index].SessionID = s.ID
+		elements[index].SessionID = s.SessionID
 		elements[index].client = s.client
 	}
 
 	return elements, nil
 }
+
+// FindElementBy returns the first element located by by, starting from the shadow root.
+func (s *ShadowRoot) FindElementBy(by By) (*Element, error) {
+	return findElementBy(s, by)
+}
+
+// FindElementsBy returns the elements located by by, starting from the shadow root.
+func (s *ShadowRoot) FindElementsBy(by By) ([]Element, error) {
+	return by.FindElements(s)
+}
@@ -1,9 +1,14 @@
 package webdriver
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/hupe1980/gowebdriver/bidi"
@@ -28,19 +33,172 @@ type WebDriver interface {
 	DeleteSession(id string) error
 }
 
+type DriverLogLevel string
+
+const (
+	DriverLogLevelAll     DriverLogLevel = "ALL"
+	DriverLogLevelDebug   DriverLogLevel = "DEBUG"
+	DriverLogLevelInfo    DriverLogLevel = "INFO"
+	DriverLogLevelWarning DriverLogLevel = "WARNING"
+	DriverLogLevelSevere  DriverLogLevel = "SEVERE"
+	DriverLogLevelOff     DriverLogLevel = "OFF"
+)
+
 type Options struct {
 	Port        int
 	BootTimeout time.Duration
+
+	// ResolveTimeout limits the resolution of a driver, including its download, if no path is given.
+	// Defaults to 5 minutes, zero or less disables it.
+	ResolveTimeout time.Duration
+
+	// LogLevel of the driver log
+	LogLevel DriverLogLevel
+
+	// LogPath writes the driver log to a file instead of stderr
+	LogPath string
+
+	// AppendLog appends to the file at LogPath instead of overwriting it
+	AppendLog bool
+
+	// Verbose logs everything, like DriverLogLevelAll
+	Verbose bool
+
+	// Stdout receives the standard output of the driver. Defaults to os.Stdout, io.Discard drops it.
+	Stdout io.Writer
+
+	// Stderr receives the standard error of the driver. Defaults to os.Stderr, io.Discard drops it.
+	Stderr io.Writer
+
+	// Logger receives every output line of the driver, for example a *slog.Logger
+	Logger Logger
+
+	// StopTimeout is the grace period for the driver to exit on Stop before it is killed
+	StopTimeout time.Duration
+}
+
+// resolveDriver resolves the driver of the browser within the resolve timeout.
+func (o Options) resolveDriver(browser Browser) (string, error) {
+	resolver, err := NewDriverResolver(browser)
+	if err != nil {
+		return "", err
+	}
+
+	ctx := context.Background()
+
+	if o.ResolveTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, o.ResolveTimeout)
+		defer cancel()
+	}
+
+	return resolver.Resolve(ctx)
+}
+
+// newService returns the service running the driver at path with the flags of the options. The
+// driver prints startedLine once it listens on its port.
+func (o Options) newService(path string, port int, startedLine string) *Service {
+	args := []string{fmt.Sprintf("--port=%d", port)}
+
+	if o.LogLevel != "" {
+		args = append(args, fmt.Sprintf("--log-level=%s", o.LogLevel))
+	}
+
+	if o.LogPath != "" {
+		args = append(args, fmt.Sprintf("--log-path=%s", o.LogPath))
+	}
+
+	if o.AppendLog {
+		args = append(args, "--append-log")
+	}
+
+	if o.Verbose {
+		args = append(args, "--verbose")
+	}
+
+	return NewService(path, args, func(so *ServiceOptions) {
+		if o.Stdout != nil {
+			so.Stdout = o.Stdout
+		}
+
+		if o.Stderr != nil {
+			so.Stderr = o.Stderr
+		}
+
+		if o.StopTimeout > 0 {
+			so.StopTimeout = o.StopTimeout
+		}
+
+		so.Logger = o.Logger
+		so.StartedLine = startedLine
+	})
 }
 
 type webDriver struct {
-	port    int
-	service *Service
-	timeout time.Duration
-	client  *RestClient
+	port        int
+	startedLine string
+	service     *Service
+	timeout     time.Duration
+	client      *RestClient
+	path        string
+	opts        Options
+	autoPort    bool
 }
 
+// maxPortAttempts limits how often a driver with an automatically chosen port is restarted on
+// another port if its port was taken in the meantime.
+const maxPortAttempts = 5
+
+func (w *webDriver) init(path, startedLine string, opts Options) error {
+	w.path = path
+	w.startedLine = startedLine
+	w.opts = opts
+	w.timeout = opts.BootTimeout
+	w.autoPort = opts.Port == 0
+
+	port := opts.Port
+	if w.autoPort {
+		var err error
+		if port, err = GetFreePort(); err != nil {
+			return err
+		}
+	}
+
+	w.setPort(port)
+
+	return nil
+}
+
+func (w *webDriver) setPort(port int) {
+	w.port = port
+	w.service = w.opts.newService(w.path, port, w.startedLine)
+	w.client = NewRestClient(fmt.Sprintf("http://127.0.0.1:%d", port))
+}
+
+// Start starts the driver. GetFreePort cannot reserve the port it returns, so a driver with an
+// automatically chosen port is restarted on another port if the port was taken in the meantime.
 func (w *webDriver) Start() error {
+	for attempt := 1; ; attempt++ {
+		err := w.start()
+		if err == nil {
+			return nil
+		}
+
+		if !w.autoPort || attempt >= maxPortAttempts || !w.portConflict(err) {
+			return err
+		}
+
+		port, perr := GetFreePort()
+		if perr != nil {
+			return err
+		}
+
+		w.setPort(port)
+	}
+}
+
+func (w *webDriver) start() error {
 	if err := w.service.Start(); err != nil {
 		return err
 	}
@@ -60,6 +218,54 @@ func (w *webDriver) Start() error {
 	return nil
 }
 
+// portConflict reports whether the driver failed because its port was already in use. The driver
+// logs the conflict, but its log may be written to LogPath, so a driver that exited during boot
+// also counts as a conflict if the port is taken.
+func (w *webDriver) portConflict(err error) bool {
+	if isAddressInUse(err) {
+		return true
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+
+	return portInUse(w.port)
+}
+
+// portInUse reports whether the port cannot be listened on.
+func portInUse(port int) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return true
+	}
+
+	_ = l.Close()
+
+	return false
+}
+
+// isAddressInUse reports whether the driver failed because its port was already in use.
+func isAddressInUse(err error) bool {
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) {
+		return false
+	}
+
+	for _, line := range serviceErr.Output {
+		line = strings.ToLower(line)
+
+		for _, msg := range []string{"address already in use", "port not available", "only one usage of each socket address"} {
+			if strings.Contains(line, msg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (w *webDriver) Stop() error {
 	if err := w.service.Stop(); err != nil {
 		return err
@@ -111,9 +317,21 @@ func (w *webDriver) Port() int {
 type SessionOptions struct {
 	AlwaysMatch Capabilities
 	FirstMatch  []Capabilities
+
+	// WebSocket configures the BiDi connection of sessions with a webSocketUrl
+	WebSocket bidi.WebSocketOptions
+}
+
+// Merge validates the capabilities and returns the merged capabilities the remote end tries to match.
+func (o SessionOptions) Merge() ([]Capabilities, error) {
+	return MergeCapabilities(o.AlwaysMatch, o.FirstMatch)
 }
 
 func (w *webDriver) newSession(opts SessionOptions) (*Session, error) {
+	if _, err := opts.Merge(); err != nil {
+		return nil, err
+	}
+
 	params := Params{
 		"alwaysMatch": opts.AlwaysMatch,
 	}
@@ -137,7 +355,9 @@ func (w *webDriver) newSession(opts SessionOptions) (*Session, error) {
 	session.client = w.client
 
 	if session.IsBiDiSession() {
-		biDiSession, err := bidi.New(session.Capabilities.WebSocketURL(), nil)
+		biDiSession, err := bidi.New(session.Capabilities.WebSocketURL(), nil, func(o *bidi.WebSocketOptions) {
+			*o = opts.WebSocket
+		})
 		if err != nil {
 			return nil, err
 		}
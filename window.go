@@ -0,0 +1,160 @@
+package webdriver
+
+import (
+	"context"
+	"time"
+)
+
+type WindowType string
+
+const (
+	WindowTypeTab    WindowType = "tab"
+	WindowTypeWindow WindowType = "window"
+)
+
+// Window is a top-level browsing context of a session. Its methods switch to the window if needed
+// and switch back to the previous window afterwards, except for Switch.
+type Window struct {
+	Handle  string     `json:"handle"`
+	Type    WindowType `json:"type"`
+	session *Session
+}
+
+// Window returns the window with the handle.
+func (s *Session) Window(handle string) *Window {
+	return &Window{Handle: handle, session: s}
+}
+
+// CurrentWindow returns the current window.
+func (s *Session) CurrentWindow() (*Window, error) {
+	handle, err := s.GetWindowHandle()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Window(handle), nil
+}
+
+// Windows returns all windows in the order of GetWindowHandles.
+func (s *Session) Windows() ([]*Window, error) {
+	handles, err := s.GetWindowHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]*Window, len(handles))
+	for i, handle := range handles {
+		windows[i] = s.Window(handle)
+	}
+
+	return windows, nil
+}
+
+// Switch changes focus to the window.
+func (w *Window) Switch() error {
+	return w.session.SwitchToWindow(w.Handle)
+}
+
+// Close closes the window. If the window is the current window, no window has focus afterwards.
+func (w *Window) Close() error {
+	return w.do(w.session.CloseWindow)
+}
+
+// Title returns the title of the document in the window.
+func (w *Window) Title() (string, error) {
+	var title string
+
+	err := w.do(func() (err error) {
+		title, err = w.session.GetTitle()
+		return err
+	})
+
+	return title, err
+}
+
+// Rect returns the size and position of the window.
+func (w *Window) Rect() (*WindowRect, error) {
+	var rect *WindowRect
+
+	err := w.do(func() (err error) {
+		rect, err = w.session.GetWindowRect()
+		return err
+	})
+
+	return rect, err
+}
+
+// SetRect sets the size and position of the window.
+func (w *Window) SetRect(rect *WindowRect) error {
+	return w.do(func() error {
+		return w.session.SetWindowRect(rect)
+	})
+}
+
+// Maximize maximizes the window.
+func (w *Window) Maximize() error {
+	return w.do(w.session.MaximizeWindow)
+}
+
+// do runs fn with the window as the current window and switches back to the previous window
+// afterwards. If the current window was closed, there is no window to switch back to.
+func (w *Window) do(fn func() error) error {
+	current, err := w.session.GetWindowHandle()
+	if err == nil && current == w.Handle {
+		return fn()
+	}
+
+	if err := w.Switch(); err != nil {
+		return err
+	}
+
+	fnErr := fn()
+
+	if current != "" {
+		if err := w.session.SwitchToWindow(current); err != nil && fnErr == nil {
+			return err
+		}
+	}
+
+	return fnErr
+}
+
+// WaitForNewWindow runs trigger, for example a click on a target=_blank link, and waits until a new
+// window is opened. It returns the new window without switching to it.
+func (s *Session) WaitForNewWindow(ctx context.Context, trigger func() error) (*Window, error) {
+	handles, err := s.GetWindowHandles()
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(handles))
+	for _, handle := range handles {
+		existing[handle] = true
+	}
+
+	if err := trigger(); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond) // nolint gomnd
+	defer ticker.Stop()
+
+	for {
+		handles, err := s.GetWindowHandles()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, handle := range handles {
+			if !existing[handle] {
+				return s.Window(handle), nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package webdriver
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindows(t *testing.T) {
+	var mu sync.Mutex
+
+	current := "w1"
+	handles := []string{"w1"}
+	titles := map[string]string{"w1": "main", "w2": "popup"}
+
+	remote := newTestRemote(t, func(cmd *testCommand) interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch path := cmd.Path; {
+		case path == "/window/new":
+			handles = append(handles, "w3")
+			return map[string]string{"handle": "w3", "type": cmd.param("type")}
+		case path == "/window/handles":
+			return handles
+		case path == "/window" && cmd.Method == http.MethodGet:
+			return current
+		case path == "/window" && cmd.Method == http.MethodPost:
+			current = cmd.param("handle")
+		case path == "/window" && cmd.Method == http.MethodDelete:
+			for i, handle := range handles {
+				if handle == current {
+					handles = append(handles[:i], handles[i+1:]...)
+					break
+				}
+			}
+		case path == "/title":
+			return titles[current]
+		}
+
+		return nil
+	})
+
+	session := remote.session("s1")
+
+	popup, err := session.WaitForNewWindow(context.Background(), func() error {
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			handles = append(handles, "w2")
+			mu.Unlock()
+		}()
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "w2", popup.Handle)
+
+	// The title of another window is read without changing the current window
+	title, err := popup.Title()
+	assert.NoError(t, err)
+	assert.Equal(t, "popup", title)
+	assert.Equal(t, "w1", current)
+
+	tab, err := session.NewWindow(WindowTypeTab)
+	assert.NoError(t, err)
+	assert.Equal(t, &Window{Handle: "w3", Type: WindowTypeTab, session: session}, tab)
+
+	assert.NoError(t, tab.Close())
+	assert.Equal(t, "w1", current)
+
+	windows, err := session.Windows()
+	assert.NoError(t, err)
+	assert.Len(t, windows, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = session.WaitForNewWindow(ctx, func() error { return nil })
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}